@@ -1,34 +1,108 @@
 package cache
 
 import (
+	"container/list"
+	"sync"
+	"time"
+
 	lru "github.com/hashicorp/golang-lru"
+
+	"github.com/bnb-chain/blob-hub/metrics"
 )
 
+// Cache keeps the blobs read from the bundle service, keyed by blob
 type Cache interface {
-	Get(key string) (interface{}, bool)
-	Set(key string, value interface{})
+	Get(key string) (string, bool)
+	// Set caches the blob, it never expires with a ttl of 0 as the blobs verified are immutable
+	Set(key string, blob string, ttl time.Duration)
 }
 
-const DefaultCacheSize = 1024
+const (
+	// maxEntryFraction caps the size of an entry to a fraction of the budget, so a single entry cannot flush the cache
+	maxEntryFraction = 8
+	// ghostKeys is the number of missed keys remembered for admission, the keys only so it takes little memory
+	ghostKeys = 65536
+)
 
+// LocalCache is an LRU cache bounded by the total size of its blobs. Once full, a blob is only admitted when its key
+// was missed before, so the blobs read once do not evict the ones read often.
 type LocalCache struct {
-	*lru.Cache
+	mtx      sync.Mutex
+	maxBytes int64
+	bytes    int64
+	entries  map[string]*list.Element
+	order    *list.List // the most recently used first
+	ghost    *lru.Cache // the keys missed recently, and whether they were missed more than once
 }
 
-func NewLocalCache(size uint64) (Cache, error) {
-	cache, err := lru.New(int(size))
+type localEntry struct {
+	key      string
+	blob     string
+	expireAt time.Time // zero for the entries never expiring
+}
+
+func NewLocalCache(maxBytes int64) (Cache, error) {
+	ghost, err := lru.New(ghostKeys)
 	if err != nil {
 		return nil, err
 	}
 	return &LocalCache{
-		cache,
+		maxBytes: maxBytes,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+		ghost:    ghost,
 	}, nil
 }
 
-func (c *LocalCache) Get(key string) (interface{}, bool) {
-	return c.Cache.Get(key)
+func (c *LocalCache) Get(key string) (string, bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	elem, ok := c.entries[key]
+	if ok {
+		entry := elem.Value.(*localEntry)
+		if entry.expireAt.IsZero() || time.Now().Before(entry.expireAt) {
+			c.order.MoveToFront(elem)
+			return entry.blob, true
+		}
+		c.remove(elem)
+	}
+	// a key missed again is admitted
+	_, missedBefore := c.ghost.Get(key)
+	c.ghost.Add(key, missedBefore)
+	return "", false
+}
+
+func (c *LocalCache) Set(key string, blob string, ttl time.Duration) {
+	size := int64(len(blob))
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if size > c.maxBytes/maxEntryFraction {
+		metrics.CacheAdmissionRejectCounter.Inc()
+		return
+	}
+	if elem, ok := c.entries[key]; ok {
+		c.remove(elem)
+	}
+	if missedAgain, _ := c.ghost.Peek(key); c.bytes+size > c.maxBytes && missedAgain != true {
+		metrics.CacheAdmissionRejectCounter.Inc()
+		return
+	}
+	for c.bytes+size > c.maxBytes {
+		c.remove(c.order.Back())
+		metrics.CacheEvictionCounter.Inc()
+	}
+	entry := &localEntry{key: key, blob: blob}
+	if ttl > 0 {
+		entry.expireAt = time.Now().Add(ttl)
+	}
+	c.entries[key] = c.order.PushFront(entry)
+	c.bytes += size
+	metrics.CacheSizeBytesGauge.Set(float64(c.bytes))
 }
 
-func (c *LocalCache) Set(key string, value interface{}) {
-	c.Cache.Add(key, value)
+func (c *LocalCache) remove(elem *list.Element) {
+	entry := c.order.Remove(elem).(*localEntry)
+	delete(c.entries, entry.key)
+	c.bytes -= int64(len(entry.blob))
+	metrics.CacheSizeBytesGauge.Set(float64(c.bytes))
 }
@@ -0,0 +1,116 @@
+package cache
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+// blob returns a blob of size bytes, the test caches hold 80 bytes so an entry is at most 10 bytes
+func blob(size int) string {
+	return strings.Repeat("b", size)
+}
+
+// op reads the key get if set, else writes the key set
+type op struct {
+	get  string
+	set  string
+	size int
+	ttl  time.Duration
+}
+
+// fill returns the writes filling a cache of 80 bytes with the keys k0 to k7, k0 being the least recently used
+func fill() []op {
+	ops := make([]op, 0, 8)
+	for i := 0; i < 8; i++ {
+		ops = append(ops, op{set: fmt.Sprintf("k%d", i), size: 10})
+	}
+	return ops
+}
+
+func TestLocalCache(t *testing.T) {
+	tests := []struct {
+		name    string
+		ops     []op
+		present []string
+		absent  []string
+	}{
+		{
+			name:    "admitted while not full",
+			ops:     []op{{set: "a", size: 10}, {set: "b", size: 10}},
+			present: []string{"a", "b"},
+		},
+		{
+			name:   "entry over the fraction rejected",
+			ops:    []op{{set: "a", size: 11}},
+			absent: []string{"a"},
+		},
+		{
+			name: "once full a key missed once is rejected",
+			ops: append(fill(),
+				op{get: "new"}, op{set: "new", size: 10}),
+			present: []string{"k0", "k7"},
+			absent:  []string{"new"},
+		},
+		{
+			name: "once full a key missed twice evicts the least recently used",
+			ops: append(fill(),
+				op{get: "k0"}, op{get: "new"}, op{get: "new"}, op{set: "new", size: 10}),
+			present: []string{"new", "k0", "k2"},
+			absent:  []string{"k1"},
+		},
+		{
+			name: "evicts as many entries as needed",
+			ops: []op{{set: "a", size: 5}, {set: "b", size: 5}, {set: "c", size: 10}, {set: "d", size: 10},
+				{set: "e", size: 10}, {set: "f", size: 10}, {set: "g", size: 10}, {set: "h", size: 10},
+				{set: "i", size: 10}, {get: "new"}, {get: "new"}, {set: "new", size: 10}},
+			present: []string{"new", "c", "i"},
+			absent:  []string{"a", "b"},
+		},
+		{
+			name:    "overwrite replaces the size",
+			ops:     append(fill(), op{set: "k0", size: 5}, op{get: "new"}, op{set: "new", size: 5}),
+			present: []string{"k0", "new", "k7"},
+		},
+		{
+			name:   "expired entry removed",
+			ops:    []op{{set: "a", size: 10, ttl: time.Nanosecond}},
+			absent: []string{"a"},
+		},
+		{
+			name:    "entry within its ttl kept",
+			ops:     []op{{set: "a", size: 10, ttl: time.Hour}},
+			present: []string{"a"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewLocalCache(80)
+			if err != nil {
+				t.Fatalf("NewLocalCache() failed, err=%s", err.Error())
+			}
+			for _, o := range tt.ops {
+				if o.get != "" {
+					c.Get(o.get)
+				} else {
+					c.Set(o.set, blob(o.size), o.ttl)
+				}
+			}
+			time.Sleep(time.Millisecond)
+			for _, key := range tt.present {
+				if _, found := c.Get(key); !found {
+					t.Errorf("Get(%s) missed, want a hit", key)
+				}
+			}
+			for _, key := range tt.absent {
+				if _, found := c.Get(key); found {
+					t.Errorf("Get(%s) hit, want a miss", key)
+				}
+			}
+			if local := c.(*LocalCache); local.bytes > local.maxBytes {
+				t.Errorf("cache holds %d bytes, over its %d bytes", local.bytes, local.maxBytes)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package cache
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/bnb-chain/blob-hub/logging"
+	"github.com/bnb-chain/blob-hub/metrics"
+)
+
+const (
+	// redisTimeout bounds a Redis call, a slow Redis should not be slower than the bundle service it saves a read of
+	redisTimeout = 500 * time.Millisecond
+	// redisRetryInterval is how long Redis is skipped after it fails, the local cache serves in the meantime
+	redisRetryInterval = 10 * time.Second
+)
+
+// RedisCache shares the blobs between the server replicas through Redis. The blobs are also kept in a local cache,
+// which serves alone while Redis is unavailable.
+type RedisCache struct {
+	client    *redis.Client
+	ttl       time.Duration
+	keyPrefix string
+	local     Cache
+
+	unavailableUntil atomic.Int64 // unix nano time until which Redis is skipped
+}
+
+func NewRedisCache(url string, ttl time.Duration, keyPrefix string, local Cache) (Cache, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+	opts.DialTimeout = redisTimeout
+	opts.ReadTimeout = redisTimeout
+	opts.WriteTimeout = redisTimeout
+	opts.MaxRetries = 0
+	return &RedisCache{
+		client:    redis.NewClient(opts),
+		ttl:       ttl,
+		keyPrefix: keyPrefix,
+		local:     local,
+	}, nil
+}
+
+func (c *RedisCache) Get(key string) (string, bool) {
+	if blob, ok := c.local.Get(key); ok {
+		return blob, true
+	}
+	if !c.available() {
+		return "", false
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+	data, err := c.client.Get(ctx, c.keyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return "", false
+	}
+	if err != nil {
+		c.fail(err)
+		return "", false
+	}
+	blob, expireAt, err := decodeBlob(data)
+	if err != nil {
+		logging.Logger.Errorf("failed to decode the cached blob %s, err=%s", key, err.Error())
+		return "", false
+	}
+	var ttl time.Duration
+	if !expireAt.IsZero() {
+		if ttl = time.Until(expireAt); ttl <= 0 {
+			return "", false
+		}
+	}
+	c.local.Set(key, blob, ttl)
+	return blob, true
+}
+
+func (c *RedisCache) Set(key string, blob string, ttl time.Duration) {
+	c.local.Set(key, blob, ttl)
+	if !c.available() {
+		return
+	}
+	var expireAt time.Time
+	redisTTL := c.ttl // the immutable blobs expire from Redis too, to bound its memory
+	if ttl > 0 {
+		expireAt = time.Now().Add(ttl)
+		redisTTL = min(ttl, c.ttl)
+	}
+	data, err := encodeBlob(blob, expireAt)
+	if err != nil {
+		logging.Logger.Errorf("failed to encode the blob %s, err=%s", key, err.Error())
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), redisTimeout)
+	defer cancel()
+	if err = c.client.Set(ctx, c.keyPrefix+key, data, redisTTL).Err(); err != nil {
+		c.fail(err)
+	}
+}
+
+func (c *RedisCache) available() bool {
+	return time.Now().UnixNano() >= c.unavailableUntil.Load()
+}
+
+// fail skips Redis for redisRetryInterval, only the first failure of a run is logged
+func (c *RedisCache) fail(err error) {
+	metrics.RedisCacheErrorCounter.Inc()
+	until := time.Now().Add(redisRetryInterval).UnixNano()
+	if previous := c.unavailableUntil.Swap(until); previous < time.Now().Add(-redisRetryInterval).UnixNano() {
+		logging.Logger.Errorf("Redis cache is unavailable, falling back to the local cache, err=%s", err.Error())
+	}
+}
+
+// encodeBlob encodes a blob as the unix nano time it expires at, 0 for the immutable blobs, followed by the blob as
+// raw bytes rather than hex to halve its size
+func encodeBlob(blob string, expireAt time.Time) ([]byte, error) {
+	blobBytes, err := hexutil.Decode(blob)
+	if err != nil {
+		return nil, err
+	}
+	var expireAtNano int64
+	if !expireAt.IsZero() {
+		expireAtNano = expireAt.UnixNano()
+	}
+	data := binary.BigEndian.AppendUint64(make([]byte, 0, 8+len(blobBytes)), uint64(expireAtNano))
+	return append(data, blobBytes...), nil
+}
+
+func decodeBlob(data []byte) (blob string, expireAt time.Time, err error) {
+	if len(data) < 8 {
+		return "", time.Time{}, fmt.Errorf("invalid cached blob of length %d", len(data))
+	}
+	if expireAtNano := int64(binary.BigEndian.Uint64(data)); expireAtNano != 0 {
+		expireAt = time.Unix(0, expireAtNano)
+	}
+	return hexutil.Encode(data[8:]), expireAt, nil
+}
@@ -0,0 +1,171 @@
+package cache
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+const testBlob = "0x0102030405060708"
+
+func newTestRedisCache(t *testing.T, server *miniredis.Miniredis) *RedisCache {
+	t.Helper()
+	local, err := NewLocalCache(1024 * 1024)
+	if err != nil {
+		t.Fatalf("NewLocalCache() failed, err=%s", err.Error())
+	}
+	c, err := NewRedisCache("redis://"+server.Addr(), time.Hour, "blob:", local)
+	if err != nil {
+		t.Fatalf("NewRedisCache() failed, err=%s", err.Error())
+	}
+	return c.(*RedisCache)
+}
+
+func TestEncodeDecodeBlob(t *testing.T) {
+	expireAt := time.Unix(0, 1700000000123456789)
+	tests := []struct {
+		name     string
+		blob     string
+		expireAt time.Time
+	}{
+		{name: "immutable", blob: testBlob},
+		{name: "expiring", blob: testBlob, expireAt: expireAt},
+		{name: "empty", blob: "0x"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := encodeBlob(tt.blob, tt.expireAt)
+			if err != nil {
+				t.Fatalf("encodeBlob() failed, err=%s", err.Error())
+			}
+			if want := 8 + (len(tt.blob)-2)/2; len(data) != want {
+				t.Errorf("encodeBlob() is %d bytes, want %d", len(data), want)
+			}
+			blob, expireAt, err := decodeBlob(data)
+			if err != nil {
+				t.Fatalf("decodeBlob() failed, err=%s", err.Error())
+			}
+			if blob != tt.blob {
+				t.Errorf("decodeBlob() blob = %s, want %s", blob, tt.blob)
+			}
+			if !expireAt.Equal(tt.expireAt) {
+				t.Errorf("decodeBlob() expireAt = %s, want %s", expireAt, tt.expireAt)
+			}
+		})
+	}
+}
+
+func TestEncodeDecodeBlobInvalid(t *testing.T) {
+	for _, blob := range []string{"", "0102", "0xzz", "0x123"} {
+		if _, err := encodeBlob(blob, time.Time{}); err == nil {
+			t.Errorf("encodeBlob(%q) succeeded, want an error", blob)
+		}
+	}
+	for _, data := range [][]byte{nil, make([]byte, 7)} {
+		if _, _, err := decodeBlob(data); err == nil {
+			t.Errorf("decodeBlob(%v) succeeded, want an error", data)
+		}
+	}
+}
+
+func TestRedisCacheShared(t *testing.T) {
+	server := miniredis.RunT(t)
+	writer := newTestRedisCache(t, server)
+	reader := newTestRedisCache(t, server)
+
+	writer.Set("1:0", testBlob, 0)
+	writer.Set("1:1", testBlob, time.Minute)
+	if ttl := server.TTL("blob:1:0"); ttl != time.Hour {
+		t.Errorf("TTL of the immutable blob = %s, want the Redis TTL %s", ttl, time.Hour)
+	}
+	if ttl := server.TTL("blob:1:1"); ttl != time.Minute {
+		t.Errorf("TTL of the unverified blob = %s, want %s", ttl, time.Minute)
+	}
+	for _, key := range []string{"1:0", "1:1"} {
+		if blob, found := reader.Get(key); !found || blob != testBlob {
+			t.Errorf("Get(%s) = %s, %t, want %s from Redis", key, blob, found, testBlob)
+		}
+		// the blob read from Redis is kept locally
+		if blob, found := reader.local.Get(key); !found || blob != testBlob {
+			t.Errorf("local Get(%s) = %s, %t, want %s", key, blob, found, testBlob)
+		}
+	}
+	if _, found := reader.Get("2:0"); found {
+		t.Errorf("Get(2:0) hit, want a miss")
+	}
+}
+
+func TestRedisCacheExpiredBlob(t *testing.T) {
+	server := miniredis.RunT(t)
+	c := newTestRedisCache(t, server)
+	data, err := encodeBlob(testBlob, time.Now().Add(-time.Second))
+	if err != nil {
+		t.Fatalf("encodeBlob() failed, err=%s", err.Error())
+	}
+	if err = server.Set("blob:1:0", string(data)); err != nil {
+		t.Fatalf("Set() failed, err=%s", err.Error())
+	}
+	if _, found := c.Get("1:0"); found {
+		t.Errorf("Get() of an expired blob hit, want a miss")
+	}
+	if err = server.Set("blob:1:1", "short"); err != nil {
+		t.Fatalf("Set() failed, err=%s", err.Error())
+	}
+	if _, found := c.Get("1:1"); found {
+		t.Errorf("Get() of an undecodable blob hit, want a miss")
+	}
+	if !c.available() {
+		t.Errorf("Redis unavailable after a decoding error, want it available")
+	}
+}
+
+func TestRedisCacheFallback(t *testing.T) {
+	server := miniredis.RunT(t)
+	c := newTestRedisCache(t, server)
+	c.Set("1:0", testBlob, 0)
+
+	server.SetError("LOADING Redis is loading the dataset in memory")
+	if _, found := c.Get("2:0"); found {
+		t.Fatalf("Get() hit while Redis fails, want a miss")
+	}
+	if c.available() {
+		t.Fatalf("Redis available after a failure, want it skipped")
+	}
+	// the local cache serves while Redis is skipped
+	if blob, found := c.Get("1:0"); !found || blob != testBlob {
+		t.Errorf("Get() = %s, %t, want %s from the local cache", blob, found, testBlob)
+	}
+	c.Set("3:0", testBlob, 0)
+	if blob, found := c.local.Get("3:0"); !found || blob != testBlob {
+		t.Errorf("local Get() = %s, %t, want %s", blob, found, testBlob)
+	}
+
+	// Redis is not called until the retry interval passed, even once it recovers
+	server.SetError("")
+	c.Set("4:0", testBlob, 0)
+	if server.Exists("blob:4:0") {
+		t.Errorf("blob written to Redis while it is skipped")
+	}
+	c.unavailableUntil.Store(time.Now().Add(-time.Second).UnixNano())
+	if !c.available() {
+		t.Fatalf("Redis skipped after the retry interval, want it available")
+	}
+	c.Set("5:0", testBlob, 0)
+	if !server.Exists("blob:5:0") {
+		t.Errorf("blob not written to Redis once available")
+	}
+}
+
+func TestRedisCacheFailExtendsUnavailability(t *testing.T) {
+	server := miniredis.RunT(t)
+	c := newTestRedisCache(t, server)
+	c.fail(errors.New("first"))
+	first := c.unavailableUntil.Load()
+	time.Sleep(time.Millisecond)
+	c.fail(errors.New("second"))
+	if second := c.unavailableUntil.Load(); second <= first {
+		t.Errorf("unavailable until %d after a second failure, want after %d", second, first)
+	}
+}
@@ -3,10 +3,18 @@ package client
 import (
 	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 
+	"github.com/bnb-chain/blob-hub/config"
+	"github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/logging"
+	"github.com/bnb-chain/blob-hub/metrics"
 	"github.com/bnb-chain/blob-hub/models"
 	blobproto "github.com/bnb-chain/blob-hub/proto"
 	"github.com/bnb-chain/blob-hub/service"
@@ -14,81 +22,438 @@ import (
 	"github.com/bnb-chain/blob-hub/util"
 )
 
+var (
+	errInvalidRequest = service.NewError(service.ReasonInvalidArgument, errors.New("invalid request"))
+	errBSCOnly        = service.NewError(service.ReasonNotSupported, errors.New("BSC blob sidecars are only served on BSC"))
+)
+
 type BlobServer struct {
 	blobproto.UnimplementedBlobServiceServer
 	service.Blob
 }
 
-func (s *BlobServer) GetBlobSidecars(ctx context.Context, req *blobproto.GetBlobSidecarsRequest) (*blobproto.GetBlobSidecarsResponse, error) {
+func (s *BlobServer) GetBlobSidecars(ctx context.Context, req *blobproto.GetBlobSidecarsRequest) (_ *blobproto.GetBlobSidecarsResponse, err error) {
+	defer func() { err = grpcError(err, "failed to get blob sidecars of block_id %s", req.GetBlockId()) }()
+	if req == nil {
+		return nil, errInvalidRequest
+	}
+	indicesInx := make([]int64, 0)
+	for _, idx := range req.GetIndices() {
+		i, err := util.StringToInt64(idx)
+		if err != nil {
+			return nil, service.NewError(service.ReasonInvalidArgument, err)
+		}
+		indicesInx = append(indicesInx, i)
+	}
+	blockNumOrSlot, archiveLag, err := resolveBlockID(ctx, req.GetBlockId())
+	if err != nil {
+		return nil, err
+	}
+	sidecars, err := service.BlobSvc.GetBlobSidecarsByBlockNumOrSlot(ctx, blockNumOrSlot, indicesInx)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*blobproto.SideCar, 0)
+	for _, sc := range sidecars {
+		data = append(data, toProtoSidecar(sc))
+	}
+	resp := &blobproto.GetBlobSidecarsResponse{
+		Data: data,
+	}
+	if archiveLag != nil {
+		resp.ArchiveLag = *archiveLag
+	}
+	return resp, nil
+}
+
+func (s *BlobServer) GetBlobsByVersionedHashes(ctx context.Context, req *blobproto.GetBlobsByVersionedHashesRequest) (_ *blobproto.GetBlobsByVersionedHashesResponse, err error) {
+	defer func() { err = grpcError(err, "failed to get blobs by versioned hashes") }()
+	if req == nil {
+		return nil, errInvalidRequest
+	}
+	data, err := getBlobsByVersionedHashes(ctx, req.GetVersionedHashes())
+	if err != nil {
+		return nil, err
+	}
+	return &blobproto.GetBlobsByVersionedHashesResponse{Data: data}, nil
+}
+
+func (s *BlobServer) GetBlobByVersionedHash(ctx context.Context, req *blobproto.GetBlobByVersionedHashRequest) (_ *blobproto.VersionedBlob, err error) {
+	defer func() { err = grpcError(err, "failed to get blob by versioned hash %s", req.GetVersionedHash()) }()
+	if req == nil {
+		return nil, errInvalidRequest
+	}
+	data, err := getBlobsByVersionedHashes(ctx, []string{req.GetVersionedHash()})
+	if err != nil {
+		return nil, err
+	}
+	return data[0], nil
+}
+
+func (s *BlobServer) GetBSCBlobSidecars(ctx context.Context, req *blobproto.GetBSCBlobSidecarsRequest) (_ *blobproto.GetBSCBlobSidecarsResponse, err error) {
+	defer func() { err = grpcError(err, "failed to get BSC blob sidecars of block_id %s", req.GetBlockId()) }()
+	if req == nil {
+		return nil, errInvalidRequest
+	}
+	if !isBSC() {
+		return nil, errBSCOnly
+	}
+	blockNum, _, err := resolveBlockID(ctx, req.GetBlockId())
+	if err != nil {
+		return nil, err
+	}
+	txSidecars, err := service.BlobSvc.GetBSCBlobTxSidecars(ctx, blockNum, req.GetFullBlob())
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*blobproto.BSCBlobTxSidecar, 0, len(txSidecars))
+	for _, txSidecar := range txSidecars {
+		protoTxSidecar, err := toProtoBSCBlobTxSidecar(txSidecar)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, protoTxSidecar)
+	}
+	return &blobproto.GetBSCBlobSidecarsResponse{Data: data}, nil
+}
+
+func (s *BlobServer) GetBSCBlobSidecarByTxHash(ctx context.Context, req *blobproto.GetBSCBlobSidecarByTxHashRequest) (_ *blobproto.BSCBlobTxSidecar, err error) {
+	defer func() { err = grpcError(err, "failed to get BSC blob sidecar of tx %s", req.GetTxHash()) }()
+	if req == nil {
+		return nil, errInvalidRequest
+	}
+	if !isBSC() {
+		return nil, errBSCOnly
+	}
+	txHash, err := hexutil.Decode(req.GetTxHash())
+	if err != nil || len(txHash) != common.HashLength {
+		return nil, service.NewError(service.ReasonInvalidArgument, fmt.Errorf("invalid tx hash %s", req.GetTxHash()))
+	}
+	// tx hashes are saved to DB without 0x
+	txSidecar, err := service.BlobSvc.GetBSCBlobTxSidecarByTxHash(ctx, hex.EncodeToString(txHash), req.GetFullBlob())
+	if err != nil {
+		return nil, err
+	}
+	return toProtoBSCBlobTxSidecar(txSidecar)
+}
+
+func (s *BlobServer) GetBlockMeta(ctx context.Context, req *blobproto.GetBlockMetaRequest) (_ *blobproto.BlockMeta, err error) {
+	defer func() { err = grpcError(err, "failed to get block meta of block_id %s", req.GetBlockId()) }()
+	if req == nil {
+		return nil, errInvalidRequest
+	}
+	blockNumOrSlot, _, err := resolveBlockID(ctx, req.GetBlockId())
+	if err != nil {
+		return nil, err
+	}
+	block, err := service.BlobSvc.GetBlockMeta(ctx, blockNumOrSlot)
+	if err != nil {
+		return nil, err
+	}
+	return &blobproto.BlockMeta{
+		Slot:          block.Slot,
+		Root:          withHexPrefix(block.Root),
+		BlockHash:     withHexPrefix(block.BlockHash),
+		ElBlockHeight: block.ELBlockHeight,
+		BlobCount:     int64(block.BlobCount),
+		BundleName:    block.BundleName,
+		Status:        blobproto.BlockStatus(block.Status),
+	}, nil
+}
+
+func (s *BlobServer) GetBundleMeta(ctx context.Context, req *blobproto.GetBundleMetaRequest) (_ *blobproto.BundleMeta, err error) {
+	defer func() { err = grpcError(err, "failed to get bundle meta of %s", req.GetBundleName()) }()
+	if req == nil {
+		return nil, errInvalidRequest
+	}
+	startSlot, endSlot, err := parseBundleName(req.GetBundleName())
+	if err != nil {
+		return nil, err
+	}
+	bundle, err := service.BlobSvc.GetBundleMeta(ctx, req.GetBundleName())
+	if err != nil {
+		return nil, err
+	}
+	return &blobproto.BundleMeta{
+		Name:        bundle.Name,
+		Status:      blobproto.BundleStatus(bundle.Status),
+		StartSlot:   startSlot,
+		EndSlot:     endSlot,
+		CreatedTime: bundle.CreatedTime,
+	}, nil
+}
+
+// StreamBlobs sends the blobs of the range, a stream failing midway is resumed by the cursor of the last blob received
+func (s *BlobServer) StreamBlobs(req *blobproto.StreamBlobsRequest, stream blobproto.BlobService_StreamBlobsServer) (err error) {
+	defer func() { err = grpcError(err, "failed to stream blobs of [%d, %d]", req.GetFrom(), req.GetTo()) }()
+	if req == nil {
+		return errInvalidRequest
+	}
+	var cursor *service.StreamCursor
+	if req.GetCursor() != "" {
+		cursor, err = service.ParseStreamCursor(req.GetCursor())
+		if err != nil {
+			return service.NewError(service.ReasonInvalidArgument, err)
+		}
+	}
+	return service.BlobSvc.StreamBlobs(stream.Context(), req.GetFrom(), req.GetTo(), cursor, func(streamed *models.StreamedBlob) error {
+		slot, err := util.StringToUint64(streamed.Slot)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&blobproto.StreamedBlob{
+			Cursor:        streamed.Cursor,
+			VersionedHash: streamed.VersionedHash,
+			Slot:          slot,
+			TxHash:        streamed.TxHash,
+			Sidecar:       toProtoSidecar(streamed.Sidecar),
+		})
+	})
+}
+
+// SubscribeBlockEvents sends the block events until the client cancels, a subscription is resumed by the id of the last
+// event received
+func (s *BlobServer) SubscribeBlockEvents(req *blobproto.SubscribeBlockEventsRequest, stream blobproto.BlobService_SubscribeBlockEventsServer) (err error) {
+	defer func() { err = grpcError(err, "block event subscription failed") }()
 	if req == nil {
-		return nil, fmt.Errorf("invalid request")
+		return errInvalidRequest
 	}
-	blockID := req.GetBlockId()
-	indices := req.GetIndices()
+	var after *int64
+	if req.GetAfter() != "" {
+		id, err := util.StringToInt64(req.GetAfter())
+		if err != nil || id < 0 {
+			return service.NewError(service.ReasonInvalidArgument, fmt.Errorf("invalid event id %s", req.GetAfter()))
+		}
+		after = &id
+	}
+	types := make([]string, 0, len(req.GetTypes()))
+	for _, t := range req.GetTypes() {
+		eventType, ok := blockEventTypes[t]
+		if !ok {
+			return service.NewError(service.ReasonInvalidArgument, fmt.Errorf("invalid event type %s", t))
+		}
+		types = append(types, eventType)
+	}
+	err = service.FeedSvc.Subscribe(stream.Context(), metrics.TransportGRPC, after, types, func(event *models.BlockEvent) error {
+		// HTTP/2 keeps the stream alive
+		if event == nil {
+			return nil
+		}
+		protoEvent, err := toProtoBlockEvent(event)
+		if err != nil {
+			return err
+		}
+		return stream.Send(protoEvent)
+	})
+	// the client cancelling ends a subscription
+	if errors.Is(err, context.Canceled) {
+		return nil
+	}
+	return err
+}
 
-	var root []byte
+// resolveBlockID returns the block number or slot of a block identifier, which is a number in decimal or 0x prefixed
+// hex, a 0x prefixed block root(ETH) or block hash(BSC), or one of head, finalized and genesis. The archive lag is only
+// returned for the latter.
+func resolveBlockID(ctx context.Context, blockID string) (uint64, *int64, error) {
 	switch blockID {
-	case "genesis", "finalized":
-		return nil, fmt.Errorf("block identifier not supported, only <slot> and <block root>")
-	default:
-		var (
-			err      error
-			sidecars []*models.Sidecar
-		)
-
-		indicesInx := make([]int64, 0)
-		for _, idx := range indices {
-			i, err := util.StringToInt64(idx)
-			if err != nil {
-				return nil, err
-			}
-			indicesInx = append(indicesInx, i)
-		}
-		root, err = hexutil.Decode(blockID)
-		if err == nil {
-			if len(root) != types.RootLength {
-				return nil, fmt.Errorf("invalid block root of length %d", len(root))
-			}
-			sidecars, err = service.BlobSvc.GetBlobSidecarsByRoot(hex.EncodeToString(root), indicesInx)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			blockNumOrSlot, err := util.StringToUint64(blockID)
-			if err != nil {
-				return nil, err
-			}
-			sidecars, err = service.BlobSvc.GetBlobSidecarsByBlockNumOrSlot(blockNumOrSlot, indicesInx)
-			if err != nil {
-				return nil, err
-			}
-		}
-		data := make([]*blobproto.SideCar, 0)
-		for _, sc := range sidecars {
-			data = append(
-				data,
-				&blobproto.SideCar{
-					Blob:                        sc.Blob,
-					Index:                       sc.Index,
-					KzgCommitment:               sc.KzgCommitment,
-					KzgCommitmentInclusionProof: sc.KzgCommitmentInclusionProof,
-					KzgProof:                    sc.KzgProof,
-					SignedBlockHeader: &blobproto.SignedBeaconBlockHeader{
-						Message: &blobproto.BeaconBlockHeader{
-							BodyRoot:      sc.SignedBlockHeader.Message.BodyRoot,
-							ParentRoot:    sc.SignedBlockHeader.Message.ParentRoot,
-							StateRoot:     sc.SignedBlockHeader.Message.StateRoot,
-							Slot:          sc.SignedBlockHeader.Message.Slot,
-							ProposerIndex: sc.SignedBlockHeader.Message.ProposerIndex,
-						},
-						Signature: sc.SignedBlockHeader.Signature,
-					},
-				},
-			)
-		}
-		return &blobproto.GetBlobSidecarsResponse{
-			Data: data,
-		}, nil
+	case service.BlockIDHead, service.BlockIDFinalized, service.BlockIDGenesis:
+		return service.BlobSvc.ResolveBlockIdentifier(ctx, blockID)
+	}
+	if !strings.HasPrefix(blockID, "0x") {
+		blockNumOrSlot, err := util.StringToUint64(blockID)
+		if err != nil {
+			return 0, nil, service.NewError(service.ReasonInvalidArgument, fmt.Errorf("invalid block_id %s", blockID))
+		}
+		return blockNumOrSlot, nil, nil
+	}
+	if len(blockID) != 2+2*types.RootLength {
+		blockNumOrSlot, err := util.HexToUint64(blockID)
+		if err != nil {
+			return 0, nil, service.NewError(service.ReasonInvalidArgument, fmt.Errorf("invalid block_id %s", blockID))
+		}
+		return blockNumOrSlot, nil, nil
+	}
+	root, err := hexutil.Decode(blockID)
+	if err != nil {
+		return 0, nil, service.NewError(service.ReasonInvalidArgument, fmt.Errorf("invalid block_id %s", blockID))
+	}
+	// roots and block hashes are saved to DB without 0x
+	var blockNumOrSlot uint64
+	if isBSC() {
+		blockNumOrSlot, err = service.BlobSvc.GetBlockNumByHash(ctx, hex.EncodeToString(root))
+	} else {
+		blockNumOrSlot, err = service.BlobSvc.GetSlotByRoot(ctx, hex.EncodeToString(root))
+	}
+	return blockNumOrSlot, nil, err
+}
+
+// isBSC tells whether the server archives BSC
+func isBSC() bool {
+	return service.BlobSvc.Chain() == config.BSC
+}
+
+// getBlobsByVersionedHashes returns the archived blobs of the versioned hashes, not found is returned when none is
+func getBlobsByVersionedHashes(ctx context.Context, versionedHashes []string) ([]*blobproto.VersionedBlob, error) {
+	hashes, err := service.ParseVersionedHashes(versionedHashes)
+	if err != nil {
+		return nil, service.NewError(service.ReasonInvalidArgument, err)
+	}
+	blobs, err := service.BlobSvc.GetBlobsByVersionedHashes(ctx, hashes)
+	if err != nil {
+		return nil, err
+	}
+	if len(blobs) == 0 {
+		return nil, service.NewError(service.ReasonBlobNotFound, errors.New("no blob archived for the versioned hashes"))
+	}
+	data := make([]*blobproto.VersionedBlob, 0, len(blobs))
+	for _, b := range blobs {
+		slot, err := util.StringToUint64(b.Slot)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, &blobproto.VersionedBlob{
+			VersionedHash: b.VersionedHash,
+			Slot:          slot,
+			TxHash:        b.TxHash,
+			Sidecar:       toProtoSidecar(b.Sidecar),
+		})
+	}
+	return data, nil
+}
+
+// parseBundleName validates a bundle name, which is of the form blobs_s{startSlot}_e{endSlot}, followed by
+// _calibrated_{timestamp} for the bundles rebuilt by the verifier
+func parseBundleName(bundleName string) (startSlot, endSlot uint64, err error) {
+	startSlot, endSlot, err = types.ParseBundleName(bundleName)
+	if err != nil {
+		return 0, 0, service.NewError(service.ReasonInvalidArgument, err)
+	}
+	return startSlot, endSlot, nil
+}
+
+// grpcError converts the error of a call to its gRPC status, the server errors are logged
+func grpcError(err error, format string, args ...interface{}) error {
+	if err == nil {
+		return nil
 	}
+	// the client going away is not an error of the server
+	if service.ReasonOf(err).HTTPStatus() >= http.StatusInternalServerError && !errors.Is(err, context.Canceled) {
+		logging.Logger.Errorf("%s, err=%s", fmt.Sprintf(format, args...), err.Error())
+	}
+	return service.GRPCError(err)
+}
 
+func toProtoSidecar(sc *models.Sidecar) *blobproto.SideCar {
+	sidecar := &blobproto.SideCar{
+		Blob:                        sc.Blob,
+		Index:                       sc.Index,
+		KzgCommitment:               sc.KzgCommitment,
+		KzgCommitmentInclusionProof: sc.KzgCommitmentInclusionProof,
+		KzgProof:                    sc.KzgProof,
+		TxHash:                      withHexPrefix(sc.TxHash),
+		TxIndex:                     sc.TxIndex,
+		Unarchived:                  sc.Unarchived,
+	}
+	// BSC blocks have no beacon block header
+	if sc.SignedBlockHeader != nil && sc.SignedBlockHeader.Message != nil {
+		sidecar.SignedBlockHeader = &blobproto.SignedBeaconBlockHeader{
+			Message: &blobproto.BeaconBlockHeader{
+				BodyRoot:      sc.SignedBlockHeader.Message.BodyRoot,
+				ParentRoot:    sc.SignedBlockHeader.Message.ParentRoot,
+				StateRoot:     sc.SignedBlockHeader.Message.StateRoot,
+				Slot:          sc.SignedBlockHeader.Message.Slot,
+				ProposerIndex: sc.SignedBlockHeader.Message.ProposerIndex,
+			},
+			Signature: sc.SignedBlockHeader.Signature,
+		}
+	}
+	return sidecar
 }
+
+func toProtoBSCBlobTxSidecar(txSidecar *models.BSCBlobTxSidecar) (*blobproto.BSCBlobTxSidecar, error) {
+	blockNum, err := util.HexToUint64(txSidecar.BlockNumber)
+	if err != nil {
+		return nil, err
+	}
+	txIndex, err := util.HexToUint64(txSidecar.TxIndex)
+	if err != nil {
+		return nil, err
+	}
+	protoTxSidecar := &blobproto.BSCBlobTxSidecar{
+		BlockHash:   txSidecar.BlockHash,
+		BlockNumber: blockNum,
+		TxHash:      txSidecar.TxHash,
+		TxIndex:     int64(txIndex),
+	}
+	if txSidecar.BlobSidecar != nil {
+		protoTxSidecar.BlobSidecar = &blobproto.BSCBlobSidecar{
+			Blobs:       txSidecar.BlobSidecar.Blobs,
+			Commitments: txSidecar.BlobSidecar.Commitments,
+			Proofs:      txSidecar.BlobSidecar.Proofs,
+		}
+	}
+	return protoTxSidecar, nil
+}
+
+var blockEventTypes = map[blobproto.BlockEventType]string{
+	blobproto.BlockEventType_BLOCK_EVENT_TYPE_ARCHIVED: models.BlockEventTypeBlockArchived,
+	blobproto.BlockEventType_BLOCK_EVENT_TYPE_VERIFIED: models.BlockEventTypeBlockVerified,
+}
+
+func toProtoBlockEvent(event *models.BlockEvent) (*blobproto.BlockEvent, error) {
+	slot, err := util.StringToUint64(event.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	protoEvent := &blobproto.BlockEvent{
+		Id:         event.ID,
+		Slot:       slot,
+		Root:       event.Root,
+		BlockHash:  event.BlockHash,
+		BundleName: event.BundleName,
+		BlobCount:  event.BlobCount,
+		Blobs:      make([]*blobproto.BlobMeta, 0, len(event.Blobs)),
+		Time:       event.Time,
+	}
+	for protoType, eventType := range blockEventTypes {
+		if eventType == event.Type {
+			protoEvent.Type = protoType
+		}
+	}
+	if event.ElBlockHeight != "" {
+		if protoEvent.ElBlockHeight, err = util.StringToUint64(event.ElBlockHeight); err != nil {
+			return nil, err
+		}
+	}
+	for _, b := range event.Blobs {
+		index, err := util.StringToInt64(b.Index)
+		if err != nil {
+			return nil, err
+		}
+		protoEvent.Blobs = append(protoEvent.Blobs, &blobproto.BlobMeta{
+			VersionedHash: b.VersionedHash,
+			Index:         index,
+			TxHash:        b.TxHash,
+			TxIndex:       b.TxIndex,
+			From:          b.From,
+			To:            b.To,
+			KzgCommitment: b.KzgCommitment,
+		})
+	}
+	return protoEvent, nil
+}
+
+// withHexPrefix adds 0x to the hashes saved to DB without it, the empty ones are kept empty
+func withHexPrefix(hash string) string {
+	if hash == "" || strings.HasPrefix(hash, "0x") {
+		return hash
+	}
+	return "0x" + hash
+}
+
+// Verify the statuses of the proto are those of the DB
+var (
+	_ = [1]struct{}{}[blobproto.BlockStatus_BLOCK_STATUS_VERIFIED-blobproto.BlockStatus(db.Verified)]
+	_ = [1]struct{}{}[blobproto.BundleStatus_BUNDLE_STATUS_DEPRECATED-blobproto.BundleStatus(db.Deprecated)]
+)
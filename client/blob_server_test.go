@@ -0,0 +1,214 @@
+package client
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/bnb-chain/blob-hub/config"
+	"github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/models"
+	"github.com/bnb-chain/blob-hub/service"
+)
+
+func TestToProtoSidecar(t *testing.T) {
+	message := &models.SidecarSignedBlockHeaderMessage{
+		BodyRoot:      "0x01",
+		ParentRoot:    "0x02",
+		StateRoot:     "0x03",
+		Slot:          "100",
+		ProposerIndex: "7",
+	}
+	tests := []struct {
+		name       string
+		header     *models.SidecarSignedBlockHeader
+		wantHeader bool
+	}{
+		{name: "ETH", header: &models.SidecarSignedBlockHeader{Message: message, Signature: "0x04"}, wantHeader: true},
+		// BSC blocks have no beacon block header
+		{name: "BSC", header: nil},
+		{name: "header without message", header: &models.SidecarSignedBlockHeader{Signature: "0x04"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := toProtoSidecar(&models.Sidecar{
+				Blob:              "0xb10b",
+				Index:             "1",
+				KzgCommitment:     "0xc0",
+				KzgProof:          "0xf0",
+				SignedBlockHeader: tt.header,
+				TxHash:            "aa",
+				TxIndex:           3,
+				Unarchived:        true,
+			})
+			if got.Blob != "0xb10b" || got.Index != "1" || got.KzgCommitment != "0xc0" || got.KzgProof != "0xf0" ||
+				got.TxIndex != 3 || !got.Unarchived {
+				t.Errorf("toProtoSidecar() = %v, fields not copied", got)
+			}
+			// tx hashes are archived without 0x
+			if got.TxHash != "0xaa" {
+				t.Errorf("toProtoSidecar() tx hash = %s, want 0xaa", got.TxHash)
+			}
+			if !tt.wantHeader {
+				if got.SignedBlockHeader != nil {
+					t.Errorf("toProtoSidecar() header = %v, want none", got.SignedBlockHeader)
+				}
+				return
+			}
+			header := got.SignedBlockHeader
+			if header == nil || header.Message == nil {
+				t.Fatalf("toProtoSidecar() has no header")
+			}
+			if header.Signature != "0x04" || header.Message.BodyRoot != message.BodyRoot ||
+				header.Message.ParentRoot != message.ParentRoot || header.Message.StateRoot != message.StateRoot ||
+				header.Message.Slot != message.Slot || header.Message.ProposerIndex != message.ProposerIndex {
+				t.Errorf("toProtoSidecar() header = %v, want %v", header, tt.header)
+			}
+		})
+	}
+}
+
+func TestToProtoBSCBlobTxSidecar(t *testing.T) {
+	blobSidecar := &models.BSCBlobSidecar{Blobs: []string{"0xb10b"}, Commitments: []string{"0xc0"}, Proofs: []string{"0xf0"}}
+	tests := []struct {
+		name        string
+		txSidecar   *models.BSCBlobTxSidecar
+		wantBlockNo uint64
+		wantTxIndex int64
+		wantErr     bool
+	}{
+		{
+			name: "with blobs",
+			txSidecar: &models.BSCBlobTxSidecar{
+				BlobSidecar: blobSidecar, BlockHash: "0xbb", BlockNumber: "0x2a", TxHash: "0xaa", TxIndex: "0x3",
+			},
+			wantBlockNo: 42,
+			wantTxIndex: 3,
+		},
+		{
+			name:        "without blobs",
+			txSidecar:   &models.BSCBlobTxSidecar{BlockHash: "0xbb", BlockNumber: "0x2a", TxHash: "0xaa", TxIndex: "0x0"},
+			wantBlockNo: 42,
+		},
+		{
+			name:      "no block number",
+			txSidecar: &models.BSCBlobTxSidecar{BlockNumber: "", TxIndex: "0x3"},
+			wantErr:   true,
+		},
+		{
+			name:      "malformed tx index",
+			txSidecar: &models.BSCBlobTxSidecar{BlockNumber: "0x2a", TxIndex: "0xzz"},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := toProtoBSCBlobTxSidecar(tt.txSidecar)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("toProtoBSCBlobTxSidecar() = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("toProtoBSCBlobTxSidecar() failed, err=%s", err.Error())
+			}
+			if got.BlockNumber != tt.wantBlockNo || got.TxIndex != tt.wantTxIndex || got.BlockHash != "0xbb" ||
+				got.TxHash != "0xaa" {
+				t.Errorf("toProtoBSCBlobTxSidecar() = %v, want block %d tx index %d", got, tt.wantBlockNo, tt.wantTxIndex)
+			}
+			if tt.txSidecar.BlobSidecar == nil {
+				if got.BlobSidecar != nil {
+					t.Errorf("toProtoBSCBlobTxSidecar() blob sidecar = %v, want none", got.BlobSidecar)
+				}
+				return
+			}
+			if got.BlobSidecar == nil || got.BlobSidecar.Blobs[0] != "0xb10b" || got.BlobSidecar.Commitments[0] != "0xc0" ||
+				got.BlobSidecar.Proofs[0] != "0xf0" {
+				t.Errorf("toProtoBSCBlobTxSidecar() blob sidecar = %v, want %v", got.BlobSidecar, blobSidecar)
+			}
+		})
+	}
+}
+
+// setUpBlobService sets service.BlobSvc to a service of the chain over a DB of the blocks, until the test ends
+func setUpBlobService(t *testing.T, chain string, blocks ...*db.Block) {
+	t.Helper()
+	gormDB, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "blob-hub.db")), &gorm.Config{
+		Logger:         logger.Default.LogMode(logger.Silent),
+		TranslateError: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = db.MigrateUp(gormDB, db.LatestSchemaVersion()); err != nil {
+		t.Fatal(err)
+	}
+	blobDB := db.NewBlobSvcDB(gormDB)
+	for _, block := range blocks {
+		if err = blobDB.SaveBlockAndBlob(block, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	previous := service.BlobSvc
+	service.BlobSvc = service.NewBlobService(blobDB, nil, nil, nil, &config.ServerConfig{Chain: chain})
+	t.Cleanup(func() { service.BlobSvc = previous })
+}
+
+func TestResolveBlockID(t *testing.T) {
+	// roots and block hashes are archived without 0x
+	root, blockHash := strings.Repeat("ab", 32), strings.Repeat("cd", 32)
+	// the blocks are built per test, saving a block sets its id
+	ethBlocks := func() []*db.Block { return []*db.Block{{Slot: 5, Root: root}, {Slot: 6}} }
+	bscBlocks := func() []*db.Block {
+		return []*db.Block{{Slot: 7, BlockHash: blockHash}, {Slot: 8, BlockHash: strings.Repeat("ef", 32)}}
+	}
+	tests := []struct {
+		name       string
+		chain      string
+		blocks     func() []*db.Block
+		blockID    string
+		want       uint64
+		wantReason service.Reason
+	}{
+		{name: "decimal", chain: config.ETH, blocks: ethBlocks, blockID: "31", want: 31},
+		{name: "short hex", chain: config.ETH, blocks: ethBlocks, blockID: "0x1f", want: 31},
+		{name: "malformed decimal", chain: config.ETH, blocks: ethBlocks, blockID: "1f", wantReason: service.ReasonInvalidArgument},
+		{name: "malformed short hex", chain: config.ETH, blocks: ethBlocks, blockID: "0x1g", wantReason: service.ReasonInvalidArgument},
+		{name: "root on ETH", chain: config.ETH, blocks: ethBlocks, blockID: "0x" + root, want: 5},
+		{name: "unknown root on ETH", chain: config.ETH, blocks: ethBlocks, blockID: "0x" + blockHash, wantReason: service.ReasonBlockNotFound},
+		{name: "block hash on BSC", chain: config.BSC, blocks: bscBlocks, blockID: "0x" + blockHash, want: 7},
+		// the roots of ETH are not block hashes
+		{name: "root on BSC", chain: config.BSC, blocks: func() []*db.Block { return append(bscBlocks(), &db.Block{Slot: 9, Root: root}) }, blockID: "0x" + root,
+			wantReason: service.ReasonBlockNotFound},
+		{name: "malformed root", chain: config.ETH, blocks: ethBlocks, blockID: "0x" + strings.Repeat("zz", 32), wantReason: service.ReasonInvalidArgument},
+		{name: "head on ETH", chain: config.ETH, blocks: ethBlocks, blockID: service.BlockIDHead, want: 5},
+		{name: "head on BSC", chain: config.BSC, blocks: bscBlocks, blockID: service.BlockIDHead, want: 8},
+		{name: "genesis", chain: config.BSC, blocks: bscBlocks, blockID: service.BlockIDGenesis, want: 7},
+		{name: "finalized not verified yet", chain: config.ETH, blocks: ethBlocks, blockID: service.BlockIDFinalized,
+			wantReason: service.ReasonNotArchivedYet},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setUpBlobService(t, tt.chain, tt.blocks()...)
+			got, _, err := resolveBlockID(context.Background(), tt.blockID)
+			if tt.wantReason != "" {
+				if err == nil || service.ReasonOf(err) != tt.wantReason {
+					t.Fatalf("resolveBlockID() = %d, %v, want reason %s", got, err, tt.wantReason)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("resolveBlockID() failed, err=%s", err.Error())
+			}
+			if got != tt.want {
+				t.Errorf("resolveBlockID() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
@@ -1,8 +1,13 @@
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -12,8 +17,11 @@ import (
 	"github.com/bnb-chain/blob-hub/logging"
 	"github.com/bnb-chain/blob-hub/metrics"
 	"github.com/bnb-chain/blob-hub/syncer"
+	"github.com/bnb-chain/blob-hub/tracing"
 )
 
+const tracingShutdownTimeout = 5 * time.Second
+
 func initFlags() {
 	flag.String(config.FlagConfigPath, "", "config file path")
 	pflag.CommandLine.AddGoFlagSet(flag.CommandLine)
@@ -38,9 +46,35 @@ func main() {
 	if cfg == nil {
 		panic("failed to get configuration")
 	}
+	if args := pflag.Args(); len(args) > 0 {
+		var err error
+		switch args[0] {
+		case "migrate":
+			err = runMigrate(cfg, args[1:])
+		case "scan":
+			err = runScan(cfg, args[1:])
+		default:
+			err = fmt.Errorf("unknown command %s, expect migrate or scan", args[0])
+		}
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
 	cfg.Validate()
 	logging.InitLogger(&cfg.LogConfig)
-	db := config.InitDBWithConfig(&cfg.DBConfig, true)
+	shutdownTracing, err := tracing.Init(&cfg.TracingConfig, tracing.ServiceNameSyncer)
+	if err != nil {
+		panic(err)
+	}
+	db := config.InitDBWithConfig(cfg.GetDBConfig())
+	if err = db.Use(tracing.NewGormPlugin()); err != nil {
+		panic(err)
+	}
+	if err := syncerdb.CheckSchemaVersion(db, syncerdb.SyncerMinSchemaVersion, syncerdb.SyncerMaxSchemaVersion); err != nil {
+		panic(err)
+	}
 	blobDB := syncerdb.NewBlobSvcDB(db)
 	bs := syncer.NewBlobSyncer(blobDB, cfg)
 	go bs.StartLoop()
@@ -53,5 +87,13 @@ func main() {
 		go metric.Start()
 	}
 
-	select {}
+	// flush the spans still buffered before exiting
+	interrupt := make(chan os.Signal, 1)
+	signal.Notify(interrupt, syscall.SIGINT, syscall.SIGTERM)
+	<-interrupt
+	ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+	defer cancel()
+	if err = shutdownTracing(ctx); err != nil {
+		logging.Logger.Errorf("failed to shutdown tracing, err=%s", err.Error())
+	}
 }
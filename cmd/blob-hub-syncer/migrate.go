@@ -0,0 +1,82 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/blob-hub/config"
+	syncerdb "github.com/bnb-chain/blob-hub/db"
+)
+
+const migrateUsage = `usage: syncer migrate <command> --config-path <config>
+  up [version]    apply the migrations up to version, the latest one by default
+  down <version>  revert the migrations after version, 0 reverts all of them
+  status          print the schema version and the applied migrations`
+
+// runMigrate runs the migrate subcommand against the DB the syncer writes to, the dry-run DB when dry run is enabled
+func runMigrate(cfg *config.SyncerConfig, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+	dbConfig := cfg.GetDBConfig()
+	dbConfig.Validate()
+	db := config.InitDBWithConfig(dbConfig)
+
+	switch args[0] {
+	case "up":
+		target := syncerdb.LatestSchemaVersion()
+		if len(args) > 1 {
+			version, err := parseVersion(args[1])
+			if err != nil {
+				return err
+			}
+			target = version
+		}
+		if err := syncerdb.MigrateUp(db, target); err != nil {
+			return err
+		}
+	case "down":
+		if len(args) < 2 {
+			return fmt.Errorf("the target version is required to migrate down\n%s", migrateUsage)
+		}
+		target, err := parseVersion(args[1])
+		if err != nil {
+			return err
+		}
+		if err = syncerdb.MigrateDown(db, target); err != nil {
+			return err
+		}
+	case "status":
+	default:
+		return fmt.Errorf("unknown migrate command %s\n%s", args[0], migrateUsage)
+	}
+	return printSchemaStatus(db)
+}
+
+func printSchemaStatus(db *gorm.DB) error {
+	applied, err := syncerdb.GetAppliedMigrations(db)
+	if err != nil {
+		return err
+	}
+	version, err := syncerdb.GetSchemaVersion(db)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("schema version %d, latest version %d\n", version, syncerdb.LatestSchemaVersion())
+	for _, m := range applied {
+		fmt.Printf("  %d %s applied at %s\n", m.Version, m.Name, time.Unix(m.AppliedTime, 0).Format(time.RFC3339))
+	}
+	return nil
+}
+
+func parseVersion(s string) (uint, error) {
+	version, err := strconv.ParseUint(s, 10, 32)
+	if err != nil {
+		return 0, fmt.Errorf("invalid schema version %s", s)
+	}
+	return uint(version), nil
+}
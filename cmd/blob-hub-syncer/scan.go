@@ -0,0 +1,69 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/bnb-chain/blob-hub/config"
+	syncerdb "github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/syncer"
+)
+
+const scanUsage = `usage: syncer scan <command> [start] [end] --config-path <config>
+  report  print the missing blocks and the blocks inconsistent with the blob and bundle tables
+  repair  print the problems and queue their repairs, which are run by the syncer
+The range defaults to the earliest block to the latest processed one.`
+
+// runScan scans the DB the syncer writes to, the dry-run DB when dry run is enabled
+func runScan(cfg *config.SyncerConfig, args []string) error {
+	if len(args) == 0 || (args[0] != "report" && args[0] != "repair") {
+		return errors.New(scanUsage)
+	}
+	dbConfig := cfg.GetDBConfig()
+	dbConfig.Validate()
+	db := config.InitDBWithConfig(dbConfig)
+	if err := syncerdb.CheckSchemaVersion(db, syncerdb.SyncerMinSchemaVersion, syncerdb.SyncerMaxSchemaVersion); err != nil {
+		return err
+	}
+	scanner := syncer.NewScanner(syncerdb.NewBlobSvcDB(db), cfg.ScanConfig.GetBatchSize(), cfg.GetCreateBundleInterval())
+
+	startBlockID, endBlockID, ok, err := scanner.GetScanRange()
+	if err != nil {
+		return err
+	}
+	if !ok {
+		fmt.Println("no block is recorded yet")
+		return nil
+	}
+	if len(args) > 1 {
+		if startBlockID, err = strconv.ParseUint(args[1], 10, 64); err != nil {
+			return fmt.Errorf("invalid start block id %s", args[1])
+		}
+	}
+	if len(args) > 2 {
+		if endBlockID, err = strconv.ParseUint(args[2], 10, 64); err != nil {
+			return fmt.Errorf("invalid end block id %s", args[2])
+		}
+	}
+	if startBlockID > endBlockID {
+		return fmt.Errorf("start block id %d is larger than end block id %d", startBlockID, endBlockID)
+	}
+
+	problems, err := scanner.Scan(startBlockID, endBlockID)
+	if err != nil {
+		return err
+	}
+	for _, p := range problems {
+		fmt.Println(p)
+	}
+	fmt.Printf("scanned block_id[%d, %d], found %d problems\n", startBlockID, endBlockID, len(problems))
+	if args[0] == "repair" && len(problems) > 0 {
+		queued, err := scanner.QueueRepairs(problems)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("queued %d repairs\n", queued)
+	}
+	return nil
+}
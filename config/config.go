@@ -4,16 +4,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net"
+	neturl "net/url"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/glebarez/sqlite"
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
-
-	"github.com/bnb-chain/blob-hub/cache"
-	syncerdb "github.com/bnb-chain/blob-hub/db"
 )
 
 const (
@@ -22,21 +24,28 @@ const (
 )
 
 type SyncerConfig struct {
-	Chain                            string        `json:"chain"`                                // support ETH and BSC
-	BucketName                       string        `json:"bucket_name"`                          // BucketName is the identifier of bucket on Greenfield that store blob
-	StartSlotOrBlock                 uint64        `json:"start_slot_or_block"`                  // StartSlotOrBlock is used to init the syncer which slot of beacon chain to synced from, only need to provide once.
-	CreateBundleSlotOrBlockInterval  uint64        `json:"create_bundle_slot_or_block_interval"` // CreateBundleSlotOrBlockInterval defines the number of slot that syncer would assemble blobs and upload to bundle service
-	BundleServiceEndpoints           []string      `json:"bundle_service_endpoints"`             // BundleServiceEndpoints is a list of bundle service address
-	BeaconRPCAddrs                   []string      `json:"beacon_rpc_addrs"`                     // BeaconRPCAddrs is a list of beacon chain RPC address
-	RPCAddrs                         []string      `json:"rpc_addrs"`                            // RPCAddrs ETH or BSC RPC addr
-	GnfdRpcAddr                      string        `json:"gnfd_rpc_addr"`                        // GnfdRpcAddr is the Greenfield RPC address
-	TempDir                          string        `json:"temp_dir"`                             // TempDir is used to store blobs and created bundle
-	PrivateKey                       string        `json:"private_key"`                          // PrivateKey is the key of bucket owner, request to bundle service will be signed by it as well.
-	BundleNotSealedReuploadThreshold int64         `json:"bundle_not_sealed_reupload_threshold"` // BundleNotSealedReuploadThreshold for re-uploading a bundle if it cant be sealed within the time threshold.
-	EnableIndivBlobVerification      bool          `json:"enable_indiv_blob_verification"`       // EnableIndivBlobVerification is used to enable individual blob verification, otherwise only bundle level verification is performed.
-	DBConfig                         DBConfig      `json:"db_config"`
-	MetricsConfig                    MetricsConfig `json:"metrics_config"`
-	LogConfig                        LogConfig     `json:"log_config"`
+	Chain                            string           `json:"chain"`                                // support ETH and BSC
+	BucketName                       string           `json:"bucket_name"`                          // BucketName is the identifier of bucket on Greenfield that store blob
+	StartSlotOrBlock                 uint64           `json:"start_slot_or_block"`                  // StartSlotOrBlock is used to init the syncer which slot of beacon chain to synced from, only need to provide once.
+	CreateBundleSlotOrBlockInterval  uint64           `json:"create_bundle_slot_or_block_interval"` // CreateBundleSlotOrBlockInterval defines the number of slot that syncer would assemble blobs and upload to bundle service
+	BundleServiceEndpoints           []string         `json:"bundle_service_endpoints"`             // BundleServiceEndpoints is a list of bundle service address
+	BeaconRPCAddrs                   []string         `json:"beacon_rpc_addrs"`                     // BeaconRPCAddrs is a list of beacon chain RPC address
+	RPCAddrs                         []string         `json:"rpc_addrs"`                            // RPCAddrs ETH or BSC RPC addr
+	GnfdRpcAddr                      string           `json:"gnfd_rpc_addr"`                        // GnfdRpcAddr is the Greenfield RPC address
+	TempDir                          string           `json:"temp_dir"`                             // TempDir is used to store blobs and created bundle
+	PrivateKey                       string           `json:"private_key"`                          // PrivateKey is the key of bucket owner, request to bundle service will be signed by it as well.
+	BundleNotSealedReuploadThreshold int64            `json:"bundle_not_sealed_reupload_threshold"` // BundleNotSealedReuploadThreshold for re-uploading a bundle if it cant be sealed within the time threshold.
+	EnableIndivBlobVerification      bool             `json:"enable_indiv_blob_verification"`       // EnableIndivBlobVerification is used to enable individual blob verification, otherwise only bundle level verification is performed.
+	TempDirMinFreeSpaceInMB          uint64           `json:"temp_dir_min_free_space_in_mb"`        // TempDirMinFreeSpaceInMB is the free disk space of TempDir below which the syncer starts warning.
+	BlockEventRetentionInHours       int64            `json:"block_event_retention_in_hours"`       // BlockEventRetentionInHours is how long the block events are kept for the subscribers of the api server to resume from.
+	DBConfig                         DBConfig         `json:"db_config"`
+	MetricsConfig                    MetricsConfig    `json:"metrics_config"`
+	QuotaAlertConfig                 QuotaAlertConfig `json:"quota_alert_config"`
+	NotifierConfig                   NotifierConfig   `json:"notifier_config"`
+	TracingConfig                    TracingConfig    `json:"tracing_config"`
+	DryRunConfig                     DryRunConfig     `json:"dry_run_config"`
+	ScanConfig                       ScanConfig       `json:"scan_config"`
+	LogConfig                        LogConfig        `json:"log_config"`
 }
 
 func (s *SyncerConfig) Validate() {
@@ -61,7 +70,7 @@ func (s *SyncerConfig) Validate() {
 	if len(s.TempDir) == 0 {
 		panic("temp directory is not specified")
 	}
-	if len(s.PrivateKey) == 0 {
+	if len(s.PrivateKey) == 0 && !s.DryRunConfig.Enable {
 		panic("private key is not provided")
 	}
 	if s.Chain == BSC && s.CreateBundleSlotOrBlockInterval > 200 {
@@ -73,8 +82,32 @@ func (s *SyncerConfig) Validate() {
 	if s.BundleNotSealedReuploadThreshold <= 60 {
 		panic("Bundle_not_sealed_reupload_threshold is supposed larger than 60 (s)")
 	}
+	if s.BlockEventRetentionInHours < 0 {
+		panic("block_event_retention_in_hours should not be negative")
+	}
 
 	s.DBConfig.Validate()
+	s.QuotaAlertConfig.Validate()
+	s.NotifierConfig.Validate()
+	s.TracingConfig.Validate()
+	s.DryRunConfig.Validate(s.TempDir, &s.DBConfig)
+	s.ScanConfig.Validate()
+}
+
+// GetTempDir returns the dir the syncer keeps blob files and bundles in, a dry run never touches the production one
+func (s *SyncerConfig) GetTempDir() string {
+	if s.DryRunConfig.Enable {
+		return s.DryRunConfig.TempDir
+	}
+	return s.TempDir
+}
+
+// GetDBConfig returns the DB the syncer writes to, a dry run never touches the production DB
+func (s *SyncerConfig) GetDBConfig() *DBConfig {
+	if s.DryRunConfig.Enable {
+		return &s.DryRunConfig.DBConfig
+	}
+	return &s.DBConfig
 }
 
 func (s *SyncerConfig) GetCreateBundleInterval() uint64 {
@@ -91,12 +124,35 @@ func (s *SyncerConfig) GetReUploadBundleThresh() int64 {
 	return s.BundleNotSealedReuploadThreshold
 }
 
+// GetTempDirMinFreeSpace returns the free disk space threshold of TempDir in bytes
+func (s *SyncerConfig) GetTempDirMinFreeSpace() uint64 {
+	if s.TempDirMinFreeSpaceInMB == 0 {
+		return DefaultTempDirMinFreeSpaceInMB * 1024 * 1024
+	}
+	return s.TempDirMinFreeSpaceInMB * 1024 * 1024
+}
+
+// GetBlockEventRetention returns how long the block events are kept
+func (s *SyncerConfig) GetBlockEventRetention() time.Duration {
+	if s.BlockEventRetentionInHours == 0 {
+		return DefaultBlockEventRetentionInHours * time.Hour
+	}
+	return time.Duration(s.BlockEventRetentionInHours) * time.Hour
+}
+
 type ServerConfig struct {
-	Chain                  string      `json:"chain"`
-	BucketName             string      `json:"bucket_name"`
-	BundleServiceEndpoints []string    `json:"bundle_service_endpoints"` // BundleServiceEndpoints is a list of bundle service address
-	CacheConfig            CacheConfig `json:"cache_config"`
-	DBConfig               DBConfig    `json:"db_config"`
+	Chain                  string             `json:"chain"`
+	Network                string             `json:"network"` // Network is one of the NetworkPresets, it is used to tell the chain head of ETH
+	BucketName             string             `json:"bucket_name"`
+	BundleServiceEndpoints []string           `json:"bundle_service_endpoints"` // BundleServiceEndpoints is a list of bundle service address
+	CacheConfig            CacheConfig        `json:"cache_config"`
+	DBConfig               DBConfig           `json:"db_config"`
+	MetricsConfig          MetricsConfig      `json:"metrics_config"` // the SP endpoint is not used by server
+	TracingConfig          TracingConfig      `json:"tracing_config"`
+	MaxStreamRange         uint64             `json:"max_stream_range"` // MaxStreamRange caps the slots or blocks of a streaming range request
+	LiveChainConfig        LiveChainConfig    `json:"live_chain_config"`
+	GRPCConfig             GRPCConfig         `json:"grpc_config"`
+	SubscriptionConfig     SubscriptionConfig `json:"subscription_config"`
 }
 
 func (s *ServerConfig) Validate() {
@@ -109,20 +165,191 @@ func (s *ServerConfig) Validate() {
 	if len(s.BundleServiceEndpoints) == 0 {
 		panic("BundleService endpoints should not be empty")
 	}
+	if s.Network != "" {
+		network, ok := NetworkPresets[s.Network]
+		if !ok {
+			panic(fmt.Sprintf("network %s not supported", s.Network))
+		}
+		if !strings.EqualFold(network.Chain, s.Chain) {
+			panic(fmt.Sprintf("network %s is not on chain %s", s.Network, s.Chain))
+		}
+	}
+	s.CacheConfig.Validate()
+	s.LiveChainConfig.Validate(s.Chain)
+	s.GRPCConfig.Validate()
+	s.SubscriptionConfig.Validate()
 	s.DBConfig.Validate()
+	s.TracingConfig.Validate()
+}
+
+// GetNetworkPreset returns the preset of the configured network, nil if not configured
+func (s *ServerConfig) GetNetworkPreset() *NetworkPreset {
+	return NetworkPresets[s.Network]
+}
+
+func (s *ServerConfig) GetMaxStreamRange() uint64 {
+	if s.MaxStreamRange == 0 {
+		return DefaultMaxStreamRange
+	}
+	return s.MaxStreamRange
+}
+
+// SubscriptionConfig configures the subscriptions to the block events, which the server polls from the DB the syncer
+// records them to
+type SubscriptionConfig struct {
+	PollIntervalInMillis int64 `json:"poll_interval_in_millis"` // PollIntervalInMillis is the pause between two polls of the block events
+	MaxSubscribers       int   `json:"max_subscribers"`         // MaxSubscribers caps the subscribers of all the transports together
+}
+
+func (cfg *SubscriptionConfig) Validate() {
+	if cfg.PollIntervalInMillis < 0 {
+		panic("poll_interval_in_millis should not be negative")
+	}
+	if cfg.MaxSubscribers < 0 {
+		panic("max_subscribers should not be negative")
+	}
+}
+
+func (cfg *SubscriptionConfig) GetPollInterval() time.Duration {
+	if cfg.PollIntervalInMillis == 0 {
+		return DefaultSubscriptionPollIntervalInMillis * time.Millisecond
+	}
+	return time.Duration(cfg.PollIntervalInMillis) * time.Millisecond
+}
+
+func (cfg *SubscriptionConfig) GetMaxSubscribers() int {
+	if cfg.MaxSubscribers == 0 {
+		return DefaultMaxSubscribers
+	}
+	return cfg.MaxSubscribers
+}
+
+// LiveChainConfig lets the server fetch the blobs not archived yet from the chain, they are verified against their KZG
+// proofs and marked as unarchived
+type LiveChainConfig struct {
+	Enable         bool     `json:"enable"`
+	RPCAddrs       []string `json:"rpc_addrs"`        // RPCAddrs are the BSC nodes
+	BeaconRPCAddrs []string `json:"beacon_rpc_addrs"` // BeaconRPCAddrs are the ETH beacon nodes
+}
+
+func (cfg *LiveChainConfig) Validate(chain string) {
+	if !cfg.Enable {
+		return
+	}
+	if strings.EqualFold(chain, BSC) && len(cfg.RPCAddrs) == 0 {
+		panic("rpc_addrs of the live chain should not be empty on BSC")
+	}
+	if strings.EqualFold(chain, ETH) && len(cfg.BeaconRPCAddrs) == 0 {
+		panic("beacon_rpc_addrs of the live chain should not be empty on ETH")
+	}
+}
+
+// GRPCConfig configures the gRPC server and its REST gateway
+type GRPCConfig struct {
+	Address        string        `json:"address"`         // Address is the gRPC listener, DefaultGRPCAddress if not set
+	GatewayAddress string        `json:"gateway_address"` // GatewayAddress is the listener of the REST gateway, which is off if not set
+	TLSConfig      GRPCTLSConfig `json:"tls_config"`
+	Reflection     bool          `json:"reflection"` // Reflection serves the gRPC server reflection, e.g. for grpcurl
+}
+
+// GRPCTLSConfig enables TLS on the gRPC listener, and mTLS when a client CA is set. The gateway verifies the server
+// certificate, and presents its own client certificate under mTLS.
+type GRPCTLSConfig struct {
+	CertFile        string `json:"cert_file"`
+	KeyFile         string `json:"key_file"`
+	ClientCAFile    string `json:"client_ca_file"`    // ClientCAFile verifies the certificates the clients are required to present
+	GatewayCertFile string `json:"gateway_cert_file"` // GatewayCertFile is the client certificate of the gateway under mTLS, issued by the client CA
+	GatewayKeyFile  string `json:"gateway_key_file"`
+}
+
+func (c *GRPCConfig) Validate() {
+	if _, _, err := net.SplitHostPort(c.GetAddress()); err != nil {
+		panic(fmt.Sprintf("invalid gRPC address %s, err=%s", c.GetAddress(), err.Error()))
+	}
+	if c.GatewayAddress != "" {
+		if _, _, err := net.SplitHostPort(c.GatewayAddress); err != nil {
+			panic(fmt.Sprintf("invalid gRPC gateway address %s, err=%s", c.GatewayAddress, err.Error()))
+		}
+		if c.GatewayAddress == c.GetAddress() {
+			panic("the gRPC gateway should not listen on the gRPC address")
+		}
+	}
+	if (c.TLSConfig.CertFile == "") != (c.TLSConfig.KeyFile == "") {
+		panic("cert_file and key_file of the gRPC TLS should be provided together")
+	}
+	if c.TLSConfig.ClientCAFile != "" && c.TLSConfig.CertFile == "" {
+		panic("client_ca_file of the gRPC TLS requires cert_file and key_file")
+	}
+	if (c.TLSConfig.GatewayCertFile == "") != (c.TLSConfig.GatewayKeyFile == "") {
+		panic("gateway_cert_file and gateway_key_file of the gRPC TLS should be provided together")
+	}
+	if c.GatewayAddress != "" && c.TLSConfig.ClientCAFile != "" && c.TLSConfig.GatewayCertFile == "" {
+		panic("the gRPC gateway requires gateway_cert_file and gateway_key_file under mTLS")
+	}
+}
+
+func (c *GRPCConfig) GetAddress() string {
+	if c.Address == "" {
+		return DefaultGRPCAddress
+	}
+	return c.Address
+}
+
+// TLSEnabled tells whether the gRPC listener serves TLS
+func (c *GRPCConfig) TLSEnabled() bool {
+	return c.TLSConfig.CertFile != ""
 }
 
 type CacheConfig struct {
-	CacheType string `json:"cache_type"`
-	URL       string `json:"url"`
-	CacheSize uint64 `json:"cache_size"`
+	CacheType              string `json:"cache_type"`                // CacheType is local, or redis shared by the replicas
+	URL                    string `json:"url"`                       // URL is the Redis URL, e.g. redis://localhost:6379/0
+	CacheSizeInMB          int64  `json:"cache_size_in_mb"`          // CacheSizeInMB bounds the blobs of the local cache, which also serves when Redis is unavailable
+	TTLInSeconds           uint64 `json:"ttl_in_seconds"`            // TTLInSeconds is the TTL of the Redis entries
+	UnverifiedTTLInSeconds uint64 `json:"unverified_ttl_in_seconds"` // UnverifiedTTLInSeconds is the TTL of the blobs not verified yet, the verified ones are immutable
+
+	// DeprecatedCacheSize is the former number of cached blobs, only read to reject the configs still setting it
+	DeprecatedCacheSize *uint64 `json:"cache_size,omitempty"`
+}
+
+func (c *CacheConfig) Validate() {
+	switch c.CacheType {
+	case CacheTypeLocal:
+	case CacheTypeRedis:
+		if c.URL == "" {
+			panic("the url of the redis cache should not be empty")
+		}
+	default:
+		panic(fmt.Sprintf("cache type %s not supported", c.CacheType))
+	}
+	if c.DeprecatedCacheSize != nil {
+		// a number of blobs has no equivalent in bytes, so the key is not migrated
+		panic("cache_size is no longer supported, the local cache is bounded by cache_size_in_mb instead")
+	}
+	if c.CacheSizeInMB < 0 {
+		panic("cache_size_in_mb should not be negative")
+	}
+}
+
+// GetCacheSize returns the size budget of the local cache in bytes
+func (c *CacheConfig) GetCacheSize() int64 {
+	if c.CacheSizeInMB != 0 {
+		return c.CacheSizeInMB * 1024 * 1024
+	}
+	return DefaultCacheSizeInMB * 1024 * 1024
 }
 
-func (c *CacheConfig) GetCacheSize() uint64 {
-	if c.CacheSize != 0 {
-		return c.CacheSize
+func (c *CacheConfig) GetUnverifiedTTL() time.Duration {
+	if c.UnverifiedTTLInSeconds != 0 {
+		return time.Duration(c.UnverifiedTTLInSeconds) * time.Second
 	}
-	return cache.DefaultCacheSize
+	return DefaultCacheUnverifiedTTLInSeconds * time.Second
+}
+
+func (c *CacheConfig) GetTTL() time.Duration {
+	if c.TTLInSeconds != 0 {
+		return time.Duration(c.TTLInSeconds) * time.Second
+	}
+	return DefaultCacheTTLInSeconds * time.Second
 }
 
 type DBConfig struct {
@@ -135,15 +362,30 @@ type DBConfig struct {
 }
 
 func (cfg *DBConfig) Validate() {
-	if cfg.Dialect != DBDialectMysql {
-		panic(fmt.Sprintf("only %s supported", DBDialectMysql))
-	}
-	if cfg.Dialect == DBDialectMysql && (cfg.Username == "" || cfg.Url == "") {
-		panic("db config is not correct, missing username and/or url")
+	switch cfg.Dialect {
+	case DBDialectMysql, DBDialectPostgres:
+		if cfg.Username == "" || cfg.Url == "" {
+			panic("db config is not correct, missing username and/or url")
+		}
+		if cfg.MaxIdleConns == 0 || cfg.MaxOpenConns == 0 {
+			panic("db connections is not correct")
+		}
+	case DBDialectSqlite:
+		if cfg.Url == "" {
+			panic("db config is not correct, missing the sqlite file path in url")
+		}
+	default:
+		panic(fmt.Sprintf("db dialect %s not supported, expect one of %s, %s and %s", cfg.Dialect,
+			DBDialectMysql, DBDialectPostgres, DBDialectSqlite))
 	}
-	if cfg.MaxIdleConns == 0 || cfg.MaxOpenConns == 0 {
-		panic("db connections is not correct")
+}
+
+// GetMaxOpenConns returns the max open connections, sqlite defaults to a single connection
+func (cfg *DBConfig) GetMaxOpenConns() int {
+	if cfg.MaxOpenConns == 0 && cfg.Dialect == DBDialectSqlite {
+		return DefaultSqliteMaxOpenConns
 	}
+	return cfg.MaxOpenConns
 }
 
 type MetricsConfig struct {
@@ -152,6 +394,257 @@ type MetricsConfig struct {
 	SPEndpoint  string `json:"sp_endpoint"`
 }
 
+// QuotaAlertConfig defines when the syncer raises events about the read quota of the bucket, it takes effect only if
+// the SP endpoint is provided in MetricsConfig.
+type QuotaAlertConfig struct {
+	WarningRemainingPercent  float64 `json:"warning_remaining_percent"`   // WarningRemainingPercent raises a warning when the remaining quota drops below the percentage of the total quota
+	CriticalRemainingPercent float64 `json:"critical_remaining_percent"`  // CriticalRemainingPercent raises a critical event when the remaining quota drops below the percentage of the total quota
+	WarningDaysToExhaustion  float64 `json:"warning_days_to_exhaustion"`  // WarningDaysToExhaustion raises a warning when the quota is forecast to run out within the days, before the monthly reset
+	CriticalDaysToExhaustion float64 `json:"critical_days_to_exhaustion"` // CriticalDaysToExhaustion raises a critical event when the quota is forecast to run out within the days, before the monthly reset
+	ForecastWindowInHours    int64   `json:"forecast_window_in_hours"`    // ForecastWindowInHours is the recent period whose consumption rate is used for forecasting
+	ThrottleVerification     bool    `json:"throttle_verification"`       // ThrottleVerification falls back to bundle level verification while the quota level is critical
+}
+
+func (cfg *QuotaAlertConfig) Validate() {
+	if cfg.WarningRemainingPercent < 0 || cfg.WarningRemainingPercent > 100 || cfg.CriticalRemainingPercent < 0 || cfg.CriticalRemainingPercent > 100 {
+		panic("remaining quota percent should be within [0, 100]")
+	}
+	if cfg.GetCriticalRemainingPercent() > cfg.GetWarningRemainingPercent() {
+		panic("critical_remaining_percent should not be larger than warning_remaining_percent")
+	}
+	if cfg.GetCriticalDaysToExhaustion() > cfg.GetWarningDaysToExhaustion() {
+		panic("critical_days_to_exhaustion should not be larger than warning_days_to_exhaustion")
+	}
+	if cfg.ForecastWindowInHours < 0 {
+		panic("forecast_window_in_hours should not be negative")
+	}
+}
+
+func (cfg *QuotaAlertConfig) GetWarningRemainingPercent() float64 {
+	if cfg.WarningRemainingPercent == 0 {
+		return DefaultQuotaWarningRemainingPercent
+	}
+	return cfg.WarningRemainingPercent
+}
+
+func (cfg *QuotaAlertConfig) GetCriticalRemainingPercent() float64 {
+	if cfg.CriticalRemainingPercent == 0 {
+		return DefaultQuotaCriticalRemainingPercent
+	}
+	return cfg.CriticalRemainingPercent
+}
+
+func (cfg *QuotaAlertConfig) GetWarningDaysToExhaustion() float64 {
+	if cfg.WarningDaysToExhaustion == 0 {
+		return DefaultQuotaWarningDaysToExhaustion
+	}
+	return cfg.WarningDaysToExhaustion
+}
+
+func (cfg *QuotaAlertConfig) GetCriticalDaysToExhaustion() float64 {
+	if cfg.CriticalDaysToExhaustion == 0 {
+		return DefaultQuotaCriticalDaysToExhaustion
+	}
+	return cfg.CriticalDaysToExhaustion
+}
+
+func (cfg *QuotaAlertConfig) GetForecastWindow() time.Duration {
+	if cfg.ForecastWindowInHours == 0 {
+		return DefaultQuotaForecastWindowInHours * time.Hour
+	}
+	return time.Duration(cfg.ForecastWindowInHours) * time.Hour
+}
+
+// ScanConfig defines the background scan of the block table for missing blocks and inconsistent records
+type ScanConfig struct {
+	Enable            bool   `json:"enable"`
+	BatchSize         uint64 `json:"batch_size"`          // BatchSize is the number of blocks scanned at each interval
+	IntervalInSeconds int64  `json:"interval_in_seconds"` // IntervalInSeconds is the pause between two batches
+	AutoRepair        bool   `json:"auto_repair"`         // AutoRepair queues the problems found for repair, otherwise they are only reported
+	MaxRepairAttempts int    `json:"max_repair_attempts"` // MaxRepairAttempts is the number of attempts of a repair before giving up
+}
+
+func (cfg *ScanConfig) Validate() {
+	if cfg.IntervalInSeconds < 0 {
+		panic("interval_in_seconds should not be negative")
+	}
+	if cfg.MaxRepairAttempts < 0 {
+		panic("max_repair_attempts should not be negative")
+	}
+}
+
+func (cfg *ScanConfig) GetBatchSize() uint64 {
+	if cfg.BatchSize == 0 {
+		return DefaultScanBatchSize
+	}
+	return cfg.BatchSize
+}
+
+func (cfg *ScanConfig) GetInterval() time.Duration {
+	if cfg.IntervalInSeconds == 0 {
+		return DefaultScanIntervalInSeconds * time.Second
+	}
+	return time.Duration(cfg.IntervalInSeconds) * time.Second
+}
+
+func (cfg *ScanConfig) GetMaxRepairAttempts() int {
+	if cfg.MaxRepairAttempts == 0 {
+		return DefaultMaxRepairAttempts
+	}
+	return cfg.MaxRepairAttempts
+}
+
+// NotifierConfig defines the webhook endpoints which archive lifecycle events are posted to
+type NotifierConfig struct {
+	Endpoints        []WebhookEndpoint `json:"endpoints"`
+	MaxAttempts      int               `json:"max_attempts"`       // MaxAttempts is the number of deliveries of an event to an endpoint before giving up
+	SyncLagThreshold uint64            `json:"sync_lag_threshold"` // SyncLagThreshold is the number of slots(ETH) or blocks(BSC) the syncer lags the chain by before raising an event, 0 to disable
+	// OutboxRetentionInHours is how long the events delivered or given up are kept in the outbox
+	OutboxRetentionInHours int64 `json:"outbox_retention_in_hours"`
+}
+
+type WebhookEndpoint struct {
+	URL    string   `json:"url"`
+	Secret string   `json:"secret"` // Secret is used to sign the payload with HMAC-SHA256
+	Events []string `json:"events"` // Events is the list of event types to deliver to the endpoint, empty for all events
+}
+
+func (cfg *NotifierConfig) Validate() {
+	urls := make(map[string]struct{})
+	for _, endpoint := range cfg.Endpoints {
+		if endpoint.URL == "" {
+			panic("webhook endpoint url should not be empty")
+		}
+		if endpoint.Secret == "" {
+			panic(fmt.Sprintf("the secret of webhook endpoint %s is not provided", endpoint.URL))
+		}
+		if _, ok := urls[endpoint.URL]; ok {
+			panic(fmt.Sprintf("duplicated webhook endpoint %s", endpoint.URL))
+		}
+		urls[endpoint.URL] = struct{}{}
+	}
+	if cfg.MaxAttempts < 0 {
+		panic("max_attempts should not be negative")
+	}
+	if cfg.OutboxRetentionInHours < 0 {
+		panic("outbox_retention_in_hours should not be negative")
+	}
+}
+
+func (cfg *NotifierConfig) Enabled() bool {
+	return len(cfg.Endpoints) > 0
+}
+
+func (cfg *NotifierConfig) GetMaxAttempts() int {
+	if cfg.MaxAttempts == 0 {
+		return DefaultWebhookMaxAttempts
+	}
+	return cfg.MaxAttempts
+}
+
+// GetOutboxRetention returns how long the events delivered or given up are kept
+func (cfg *NotifierConfig) GetOutboxRetention() time.Duration {
+	if cfg.OutboxRetentionInHours == 0 {
+		return DefaultOutboxRetentionInHours * time.Hour
+	}
+	return time.Duration(cfg.OutboxRetentionInHours) * time.Hour
+}
+
+// Subscribed returns whether the endpoint subscribes to the event type
+func (e *WebhookEndpoint) Subscribed(eventType string) bool {
+	if len(e.Events) == 0 {
+		return true
+	}
+	for _, event := range e.Events {
+		if event == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// DryRunConfig defines the shadow mode of the syncer. Blobs are fetched, validated and assembled into bundles as
+// usual, but the bundles are written to a sink instead of bundle service, and the progress is saved to a separate DB.
+// Verification is skipped, as nothing is uploaded to Greenfield.
+type DryRunConfig struct {
+	Enable   bool     `json:"enable"`
+	Sink     string   `json:"sink"`      // Sink is where the bundles go, either "noop" to discard them or "local" to keep them in LocalDir
+	LocalDir string   `json:"local_dir"` // LocalDir is the dir the local sink writes bundle files to
+	TempDir  string   `json:"temp_dir"`  // TempDir replaces the temp dir of the syncer, it must differ from the production one
+	DBConfig DBConfig `json:"db_config"` // DBConfig is the DB of the dry run, it must differ from the production DB
+}
+
+func (cfg *DryRunConfig) Validate(prodTempDir string, prodDBConfig *DBConfig) {
+	if !cfg.Enable {
+		return
+	}
+	// the production syncer removes what it does not recognize as its own from its temp dir
+	if cfg.TempDir == "" || isWithinDir(cfg.TempDir, prodTempDir) {
+		panic("the dry run should use a separate temp dir from the production one, and not within it")
+	}
+	switch cfg.Sink {
+	case DryRunSinkNoop:
+	case DryRunSinkLocal:
+		if cfg.LocalDir == "" {
+			panic("local_dir of the dry run should not be empty for the local sink")
+		}
+		if isWithinDir(cfg.LocalDir, prodTempDir) {
+			panic("local_dir of the dry run should not be within the temp dir of the production one")
+		}
+	default:
+		panic(fmt.Sprintf("dry run sink %s not supported, only %s and %s", cfg.Sink, DryRunSinkNoop, DryRunSinkLocal))
+	}
+	cfg.DBConfig.Validate()
+	if cfg.DBConfig.Url == prodDBConfig.Url {
+		panic("the dry run should use a separate DB from the production one")
+	}
+}
+
+// isWithinDir returns whether dir is parent or nested inside it, the relative paths are resolved against the working dir
+func isWithinDir(dir, parent string) bool {
+	absDir, err := filepath.Abs(dir)
+	if err != nil {
+		return false
+	}
+	absParent, err := filepath.Abs(parent)
+	if err != nil {
+		return false
+	}
+	rel, err := filepath.Rel(absParent, absDir)
+	if err != nil {
+		return false
+	}
+	return rel == "." || (rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)))
+}
+
+// TracingConfig defines the OTLP collector which traces are exported to
+type TracingConfig struct {
+	Enable      bool    `json:"enable"`
+	Endpoint    string  `json:"endpoint"`     // Endpoint is the host:port of the OTLP/HTTP collector
+	Insecure    bool    `json:"insecure"`     // Insecure exports traces over plain HTTP instead of HTTPS
+	ServiceName string  `json:"service_name"` // ServiceName defaults to the name of the binary
+	SampleRatio float64 `json:"sample_ratio"` // SampleRatio is the ratio of traces started locally to be sampled, 0 means all
+}
+
+func (cfg *TracingConfig) Validate() {
+	if !cfg.Enable {
+		return
+	}
+	if cfg.Endpoint == "" {
+		panic("tracing endpoint should not be empty")
+	}
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		panic("tracing sample_ratio should be within [0, 1]")
+	}
+}
+
+func (cfg *TracingConfig) GetSampleRatio() float64 {
+	if cfg.SampleRatio == 0 {
+		return 1
+	}
+	return cfg.SampleRatio
+}
+
 type LogConfig struct {
 	Level                        string `json:"level"`
 	Filename                     string `json:"filename"`
@@ -190,6 +683,9 @@ func ParseSyncerConfigFromFile(filePath string) *SyncerConfig {
 	if config.DBConfig.Username == "" || config.DBConfig.Password == "" { // read password from ENV
 		config.DBConfig.Username, config.DBConfig.Password = GetDBUsernamePasswordFromEnv()
 	}
+	if config.DryRunConfig.Enable && (config.DryRunConfig.DBConfig.Username == "" || config.DryRunConfig.DBConfig.Password == "") {
+		config.DryRunConfig.DBConfig.Username, config.DryRunConfig.DBConfig.Password = GetDBUsernamePasswordFromEnv()
+	}
 	if config.PrivateKey == "" { // read private key from ENV
 		config.PrivateKey = os.Getenv(EnvVarPrivateKey)
 	}
@@ -217,16 +713,25 @@ func GetDBUsernamePasswordFromEnv() (string, string) {
 	return username, password
 }
 
-func InitDBWithConfig(cfg *DBConfig, writeAccess bool) *gorm.DB {
+// InitDBWithConfig opens the DB, the schema is managed by the migrate command of the syncer, see db.MigrateUp
+func InitDBWithConfig(cfg *DBConfig) *gorm.DB {
 	var db *gorm.DB
 	var err error
 	var dialector gorm.Dialector
 
-	if cfg.Dialect == DBDialectMysql {
+	switch cfg.Dialect {
+	case DBDialectMysql:
 		url := cfg.Url
 		dbPath := fmt.Sprintf("%s:%s@%s", cfg.Username, cfg.Password, url)
 		dialector = mysql.Open(dbPath)
-	} else {
+	case DBDialectPostgres:
+		// url is the part after the credentials, e.g. localhost:5432/blob_hub?sslmode=disable
+		dbPath := fmt.Sprintf("postgres://%s@%s", neturl.UserPassword(cfg.Username, cfg.Password).String(), cfg.Url)
+		dialector = postgres.Open(dbPath)
+	case DBDialectSqlite:
+		// url is the database file path, pragmas can be appended, e.g. blob-hub.db?_pragma=busy_timeout(5000)
+		dialector = sqlite.Open(cfg.Url)
+	default:
 		panic(fmt.Sprintf("unexpected DB dialect %s", cfg.Dialect))
 	}
 	newLogger := logger.New(
@@ -240,6 +745,8 @@ func InitDBWithConfig(cfg *DBConfig, writeAccess bool) *gorm.DB {
 	)
 	db, err = gorm.Open(dialector, &gorm.Config{
 		Logger: newLogger,
+		// map the dialect specific constraint errors to gorm errors, see db.IsDuplicateKeyErr
+		TranslateError: true,
 	})
 	if err != nil {
 		panic(fmt.Sprintf("open db error, err=%s", err.Error()))
@@ -249,9 +756,6 @@ func InitDBWithConfig(cfg *DBConfig, writeAccess bool) *gorm.DB {
 		panic(err)
 	}
 	dbConfig.SetMaxIdleConns(cfg.MaxIdleConns)
-	dbConfig.SetMaxOpenConns(cfg.MaxOpenConns)
-	if writeAccess {
-		syncerdb.AutoMigrateDB(db)
-	}
+	dbConfig.SetMaxOpenConns(cfg.GetMaxOpenConns())
 	return db
 }
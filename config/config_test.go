@@ -0,0 +1,102 @@
+package config
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"testing"
+)
+
+func TestDryRunConfigValidate(t *testing.T) {
+	prodDBConfig := &DBConfig{Dialect: DBDialectSqlite, Url: "prod.db"}
+	prodTempDir := filepath.Join(t.TempDir(), "temp")
+	tests := []struct {
+		name      string
+		cfg       DryRunConfig
+		wantPanic bool
+	}{
+		{name: "disabled", cfg: DryRunConfig{TempDir: prodTempDir}},
+		{name: "noop sink", cfg: DryRunConfig{Enable: true, Sink: DryRunSinkNoop, TempDir: "shadow-temp", DBConfig: DBConfig{Dialect: DBDialectSqlite, Url: "shadow.db"}}},
+		{name: "local sink", cfg: DryRunConfig{Enable: true, Sink: DryRunSinkLocal, LocalDir: "shadow-bundles", TempDir: "shadow-temp", DBConfig: DBConfig{Dialect: DBDialectSqlite, Url: "shadow.db"}}},
+		{name: "temp dir sharing a prefix", cfg: DryRunConfig{Enable: true, Sink: DryRunSinkNoop, TempDir: prodTempDir + "-shadow", DBConfig: DBConfig{Dialect: DBDialectSqlite, Url: "shadow.db"}}},
+		{name: "empty temp dir", cfg: DryRunConfig{Enable: true, Sink: DryRunSinkNoop, DBConfig: DBConfig{Dialect: DBDialectSqlite, Url: "shadow.db"}}, wantPanic: true},
+		{name: "same temp dir", cfg: DryRunConfig{Enable: true, Sink: DryRunSinkNoop, TempDir: prodTempDir + "/", DBConfig: DBConfig{Dialect: DBDialectSqlite, Url: "shadow.db"}}, wantPanic: true},
+		{name: "temp dir within", cfg: DryRunConfig{Enable: true, Sink: DryRunSinkNoop, TempDir: filepath.Join(prodTempDir, "shadow"), DBConfig: DBConfig{Dialect: DBDialectSqlite, Url: "shadow.db"}}, wantPanic: true},
+		{name: "local dir within", cfg: DryRunConfig{Enable: true, Sink: DryRunSinkLocal, LocalDir: filepath.Join(prodTempDir, "bundles"), TempDir: "shadow-temp", DBConfig: DBConfig{Dialect: DBDialectSqlite, Url: "shadow.db"}}, wantPanic: true},
+		{name: "same local dir", cfg: DryRunConfig{Enable: true, Sink: DryRunSinkLocal, LocalDir: prodTempDir, TempDir: "shadow-temp", DBConfig: DBConfig{Dialect: DBDialectSqlite, Url: "shadow.db"}}, wantPanic: true},
+		{name: "empty local dir", cfg: DryRunConfig{Enable: true, Sink: DryRunSinkLocal, TempDir: "shadow-temp", DBConfig: DBConfig{Dialect: DBDialectSqlite, Url: "shadow.db"}}, wantPanic: true},
+		{name: "unknown sink", cfg: DryRunConfig{Enable: true, Sink: "s3", TempDir: "shadow-temp", DBConfig: DBConfig{Dialect: DBDialectSqlite, Url: "shadow.db"}}, wantPanic: true},
+		{name: "same DB", cfg: DryRunConfig{Enable: true, Sink: DryRunSinkNoop, TempDir: "shadow-temp", DBConfig: DBConfig{Dialect: DBDialectSqlite, Url: "prod.db"}}, wantPanic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); (r != nil) != tt.wantPanic {
+					t.Errorf("Validate() panic = %v, want panic %t", r, tt.wantPanic)
+				}
+			}()
+			tt.cfg.Validate(prodTempDir, prodDBConfig)
+		})
+	}
+}
+
+func TestCacheConfigValidate(t *testing.T) {
+	tests := []struct {
+		name      string
+		json      string
+		wantPanic bool
+	}{
+		{name: "local", json: `{"cache_type": "local", "cache_size_in_mb": 512}`},
+		{name: "default size", json: `{"cache_type": "local"}`},
+		{name: "redis", json: `{"cache_type": "redis", "url": "redis://localhost:6379/0"}`},
+		{name: "redis without url", json: `{"cache_type": "redis"}`, wantPanic: true},
+		{name: "unknown type", json: `{"cache_type": "memcached"}`, wantPanic: true},
+		{name: "negative size", json: `{"cache_type": "local", "cache_size_in_mb": -1}`, wantPanic: true},
+		{name: "former size key", json: `{"cache_type": "local", "cache_size": 1024}`, wantPanic: true},
+		{name: "former size key zero", json: `{"cache_type": "local", "cache_size": 0}`, wantPanic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cfg CacheConfig
+			if err := json.Unmarshal([]byte(tt.json), &cfg); err != nil {
+				t.Fatalf("Unmarshal() failed, err=%s", err.Error())
+			}
+			defer func() {
+				if r := recover(); (r != nil) != tt.wantPanic {
+					t.Errorf("Validate() panic = %v, want panic %t", r, tt.wantPanic)
+				}
+			}()
+			cfg.Validate()
+		})
+	}
+}
+
+func TestGRPCConfigValidate(t *testing.T) {
+	tls := GRPCTLSConfig{CertFile: "server.pem", KeyFile: "server.key"}
+	mTLS := GRPCTLSConfig{CertFile: "server.pem", KeyFile: "server.key", ClientCAFile: "ca.pem"}
+	gatewayMTLS := GRPCTLSConfig{CertFile: "server.pem", KeyFile: "server.key", ClientCAFile: "ca.pem", GatewayCertFile: "gateway.pem", GatewayKeyFile: "gateway.key"}
+	tests := []struct {
+		name      string
+		cfg       GRPCConfig
+		wantPanic bool
+	}{
+		{name: "default", cfg: GRPCConfig{}},
+		{name: "gateway over TLS", cfg: GRPCConfig{GatewayAddress: "0.0.0.0:8081", TLSConfig: tls}},
+		{name: "mTLS without gateway", cfg: GRPCConfig{TLSConfig: mTLS}},
+		{name: "gateway over mTLS", cfg: GRPCConfig{GatewayAddress: "0.0.0.0:8081", TLSConfig: gatewayMTLS}},
+		{name: "gateway over mTLS without its certificate", cfg: GRPCConfig{GatewayAddress: "0.0.0.0:8081", TLSConfig: mTLS}, wantPanic: true},
+		{name: "gateway certificate without key", cfg: GRPCConfig{TLSConfig: GRPCTLSConfig{CertFile: "server.pem", KeyFile: "server.key", GatewayCertFile: "gateway.pem"}}, wantPanic: true},
+		{name: "certificate without key", cfg: GRPCConfig{TLSConfig: GRPCTLSConfig{CertFile: "server.pem"}}, wantPanic: true},
+		{name: "client CA without certificate", cfg: GRPCConfig{TLSConfig: GRPCTLSConfig{ClientCAFile: "ca.pem"}}, wantPanic: true},
+		{name: "gateway on the gRPC address", cfg: GRPCConfig{GatewayAddress: DefaultGRPCAddress}, wantPanic: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			defer func() {
+				if r := recover(); (r != nil) != tt.wantPanic {
+					t.Errorf("Validate() panic = %v, want panic %t", r, tt.wantPanic)
+				}
+			}()
+			tt.cfg.Validate()
+		})
+	}
+}
@@ -3,7 +3,14 @@ package config
 const (
 	FlagConfigPath = "config-path"
 
-	DBDialectMysql = "mysql"
+	DBDialectMysql    = "mysql"
+	DBDialectPostgres = "postgres"
+	DBDialectSqlite   = "sqlite"
+
+	DefaultSqliteMaxOpenConns = 1 // sqlite allows a single writer, more connections only end up in "database is locked"
+
+	DryRunSinkNoop  = "noop"
+	DryRunSinkLocal = "local"
 
 	EnvVarConfigFilePath = "CONFIG_FILE_PATH"
 	EnvVarDBUserName     = "DB_USERNAME"
@@ -14,4 +21,34 @@ const (
 
 	DefaultReUploadBundleThreshold = 3600 // in second
 
+	DefaultTempDirMinFreeSpaceInMB = 1024
+
+	DefaultQuotaWarningRemainingPercent  = 20
+	DefaultQuotaCriticalRemainingPercent = 5
+	DefaultQuotaWarningDaysToExhaustion  = 7
+	DefaultQuotaCriticalDaysToExhaustion = 2
+	DefaultQuotaForecastWindowInHours    = 24
+
+	DefaultWebhookMaxAttempts     = 10
+	DefaultOutboxRetentionInHours = 7 * 24
+
+	DefaultScanBatchSize         = 1000
+	DefaultScanIntervalInSeconds = 10
+	DefaultMaxRepairAttempts     = 5
+
+	DefaultMaxStreamRange = 1000
+
+	DefaultBlockEventRetentionInHours = 24
+
+	DefaultSubscriptionPollIntervalInMillis = 1000
+	DefaultMaxSubscribers                   = 1000
+
+	DefaultGRPCAddress = "0.0.0.0:9000"
+
+	CacheTypeLocal = "local"
+	CacheTypeRedis = "redis"
+
+	DefaultCacheSizeInMB               = 512
+	DefaultCacheTTLInSeconds           = 3600
+	DefaultCacheUnverifiedTTLInSeconds = 60
 )
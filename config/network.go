@@ -0,0 +1,122 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// NetworkPreset defines the parameters of a known network, which the api server can't learn from the archive
+type NetworkPreset struct {
+	Name                    string
+	Chain                   string
+	GenesisTime             uint64 // GenesisTime is the unix time of the beacon chain genesis
+	GenesisValidatorsRoot   string
+	GenesisForkVersion      string
+	SecondsPerSlot          uint64
+	SlotsPerEpoch           uint64
+	MaxBlobsPerBlock        uint64  // MaxBlobsPerBlock is the limit of deneb
+	MaxBlobsPerBlockElectra uint64  // MaxBlobsPerBlockElectra is the limit of electra, fulu on schedule theirs by BLOB_SCHEDULE
+	Forks                   []*Fork // Forks is ordered by epoch, starting from the first fork carrying blobs
+}
+
+// Fork is a consensus fork activated at an epoch
+type Fork struct {
+	Name    string
+	Epoch   uint64
+	Version string
+}
+
+// DefaultConsensusVersion is the consensus version of the blob sidecars when no network is configured
+const DefaultConsensusVersion = "deneb"
+
+var NetworkPresets = map[string]*NetworkPreset{
+	"mainnet": {
+		Name:                    "mainnet",
+		Chain:                   ETH,
+		GenesisTime:             1606824023,
+		GenesisValidatorsRoot:   "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95",
+		GenesisForkVersion:      "0x00000000",
+		SecondsPerSlot:          12,
+		SlotsPerEpoch:           32,
+		MaxBlobsPerBlock:        6,
+		MaxBlobsPerBlockElectra: 9,
+		Forks: []*Fork{
+			{Name: "deneb", Epoch: 269568, Version: "0x04000000"},
+			{Name: "electra", Epoch: 364032, Version: "0x05000000"},
+			{Name: "fulu", Epoch: 411392, Version: "0x06000000"},
+		},
+	},
+	"sepolia": {
+		Name:                    "sepolia",
+		Chain:                   ETH,
+		GenesisTime:             1655733600,
+		GenesisValidatorsRoot:   "0xd8ea171f3c94aea21ebc42a1ed61052acf3f9209c00e4efbaaddac09ed9b8078",
+		GenesisForkVersion:      "0x90000069",
+		SecondsPerSlot:          12,
+		SlotsPerEpoch:           32,
+		MaxBlobsPerBlock:        6,
+		MaxBlobsPerBlockElectra: 9,
+		Forks: []*Fork{
+			{Name: "deneb", Epoch: 132608, Version: "0x90000073"},
+			{Name: "electra", Epoch: 222464, Version: "0x90000074"},
+			{Name: "fulu", Epoch: 272640, Version: "0x90000075"},
+		},
+	},
+	"holesky": {
+		Name:                    "holesky",
+		Chain:                   ETH,
+		GenesisTime:             1695902400,
+		GenesisValidatorsRoot:   "0x9143aa7c615a7f7115e2b6aac319c03529df8242ae705fba9df39b79c59fa8b1",
+		GenesisForkVersion:      "0x01017000",
+		SecondsPerSlot:          12,
+		SlotsPerEpoch:           32,
+		MaxBlobsPerBlock:        6,
+		MaxBlobsPerBlockElectra: 9,
+		Forks: []*Fork{
+			{Name: "deneb", Epoch: 29696, Version: "0x05017000"},
+			{Name: "electra", Epoch: 115968, Version: "0x06017000"},
+			{Name: "fulu", Epoch: 165120, Version: "0x07017000"},
+		},
+	},
+}
+
+// CurrentSlot returns the slot of the chain head by the wall clock
+func (n *NetworkPreset) CurrentSlot(now time.Time) uint64 {
+	if uint64(now.Unix()) < n.GenesisTime {
+		return 0
+	}
+	return (uint64(now.Unix()) - n.GenesisTime) / n.SecondsPerSlot
+}
+
+// ConsensusVersion returns the name of the fork the slot belongs to
+func (n *NetworkPreset) ConsensusVersion(slot uint64) string {
+	version := DefaultConsensusVersion
+	for _, fork := range n.Forks {
+		if slot/n.SlotsPerEpoch < fork.Epoch {
+			break
+		}
+		version = fork.Name
+	}
+	return version
+}
+
+// Spec returns the values of the network served by /eth/v1/config/spec, the subset the rollup nodes read
+func (n *NetworkPreset) Spec() map[string]string {
+	spec := map[string]string{
+		"CONFIG_NAME":          n.Name,
+		"PRESET_BASE":          "mainnet",
+		"GENESIS_FORK_VERSION": n.GenesisForkVersion,
+		"SECONDS_PER_SLOT":     fmt.Sprintf("%d", n.SecondsPerSlot),
+		"SLOTS_PER_EPOCH":      fmt.Sprintf("%d", n.SlotsPerEpoch),
+		"MAX_BLOBS_PER_BLOCK":  fmt.Sprintf("%d", n.MaxBlobsPerBlock),
+	}
+	if n.MaxBlobsPerBlockElectra != 0 {
+		spec["MAX_BLOBS_PER_BLOCK_ELECTRA"] = fmt.Sprintf("%d", n.MaxBlobsPerBlockElectra)
+	}
+	for _, fork := range n.Forks {
+		spec[strings.ToUpper(fork.Name)+"_FORK_EPOCH"] = fmt.Sprintf("%d", fork.Epoch)
+		spec[strings.ToUpper(fork.Name)+"_FORK_VERSION"] = fork.Version
+	}
+	return spec
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNetworkPresetCurrentSlot(t *testing.T) {
+	mainnet := NetworkPresets["mainnet"]
+	tests := []struct {
+		name string
+		now  time.Time
+		want uint64
+	}{
+		{name: "before genesis", now: time.Unix(1606824023-1, 0), want: 0},
+		{name: "genesis", now: time.Unix(1606824023, 0), want: 0},
+		{name: "within the first slot", now: time.Unix(1606824023+11, 0), want: 0},
+		{name: "second slot", now: time.Unix(1606824023+12, 0), want: 1},
+		{name: "deneb", now: time.Unix(1710338135, 0), want: 269568 * 32},
+		{name: "electra", now: time.Unix(1746612311, 0), want: 364032 * 32},
+		{name: "fulu", now: time.Unix(1764798551, 0), want: 411392 * 32},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mainnet.CurrentSlot(tt.now); got != tt.want {
+				t.Errorf("CurrentSlot() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNetworkPresetConsensusVersion(t *testing.T) {
+	tests := []struct {
+		network string
+		slot    uint64
+		want    string
+	}{
+		{network: "mainnet", slot: 0, want: "deneb"},
+		{network: "mainnet", slot: 269568*32 - 1, want: "deneb"},
+		{network: "mainnet", slot: 269568 * 32, want: "deneb"},
+		{network: "mainnet", slot: 364032*32 - 1, want: "deneb"},
+		{network: "mainnet", slot: 364032 * 32, want: "electra"},
+		{network: "mainnet", slot: 411392*32 - 1, want: "electra"},
+		{network: "mainnet", slot: 411392 * 32, want: "fulu"},
+		{network: "mainnet", slot: 1 << 40, want: "fulu"},
+		{network: "sepolia", slot: 222464 * 32, want: "electra"},
+		{network: "sepolia", slot: 272640 * 32, want: "fulu"},
+		{network: "holesky", slot: 115968*32 - 1, want: "deneb"},
+		{network: "holesky", slot: 165120 * 32, want: "fulu"},
+	}
+	for _, tt := range tests {
+		if got := NetworkPresets[tt.network].ConsensusVersion(tt.slot); got != tt.want {
+			t.Errorf("%s ConsensusVersion(%d) = %s, want %s", tt.network, tt.slot, got, tt.want)
+		}
+	}
+}
+
+func TestNetworkPresetForks(t *testing.T) {
+	for name, network := range NetworkPresets {
+		if network.Name != name {
+			t.Errorf("preset %s is named %s", name, network.Name)
+		}
+		for i := 1; i < len(network.Forks); i++ {
+			if network.Forks[i].Epoch <= network.Forks[i-1].Epoch {
+				t.Errorf("forks of %s are not ordered by epoch", name)
+			}
+		}
+		spec := network.Spec()
+		if spec["MAX_BLOBS_PER_BLOCK"] != "6" || spec["MAX_BLOBS_PER_BLOCK_ELECTRA"] != "9" {
+			t.Errorf("blob limits of %s = %s, %s, want 6, 9", name, spec["MAX_BLOBS_PER_BLOCK"], spec["MAX_BLOBS_PER_BLOCK_ELECTRA"])
+		}
+	}
+}
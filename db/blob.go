@@ -5,7 +5,8 @@ type Blob struct {
 	Name                     string `gorm:"NOT NULL;uniqueIndex:idx_blob_name;size:96"` // the identifier of blob object in bundle service
 	TxHash                   string `gorm:"NOT NULL;index:idx_blob_tx_hash"`
 	ToAddr                   string `gorm:"NOT NULL;index:idx_blob_to_address"`
-	VersionedHash            string `gorm:"NOT NULL"`
+	FromAddr                 string `gorm:"NOT NULL;default:'';index:idx_blob_from_address;size:42"` // empty for the blobs recorded before schema version 4
+	VersionedHash            string `gorm:"NOT NULL;index:idx_blob_versioned_hash;size:66"`
 	Slot                     uint64 `gorm:"NOT NULL;index:idx_blob_slot_index"`
 	Idx                      int    `gorm:"NOT NULL;index:idx_blob_slot_idx"`
 	TxIndex                  int    `gorm:"comment:txIndex"`
@@ -17,3 +18,12 @@ type Blob struct {
 func (*Blob) TableName() string {
 	return "blob"
 }
+
+// AddressRole is the side of the blob tx an address is looked up on
+type AddressRole string
+
+const (
+	AddressRoleAny  AddressRole = ""
+	AddressRoleFrom AddressRole = "from"
+	AddressRoleTo   AddressRole = "to"
+)
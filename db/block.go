@@ -18,6 +18,7 @@ type Block struct {
 	Signature     string
 	Slot          uint64 `gorm:"NOT NULL;uniqueIndex:idx_block_slot"`
 	ELBlockHeight uint64 // the eth1 block height
+	BlockHash     string `gorm:"NOT NULL;default:'';index:idx_block_block_hash;size:64"` // the hash of the eth1(ETH) or BSC block, empty for the blocks recorded before schema version 5
 	BlobCount     int
 
 	BundleName string `gorm:"NOT NULL"`
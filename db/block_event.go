@@ -0,0 +1,21 @@
+package db
+
+type BlockEventType string
+
+const (
+	BlockArchived BlockEventType = "block_archived" // the block and its blobs are saved by the syncer
+	BlockVerified BlockEventType = "block_verified" // the blobs of the block are verified against the bundle
+)
+
+// BlockEvent is an entry of the change feed of the block table, written in the transaction changing the block so that
+// the api server can follow the syncer from the DB. Events are ordered by Id and pruned after a retention period.
+type BlockEvent struct {
+	Id          int64
+	EventType   BlockEventType `gorm:"NOT NULL;size:32"`
+	Slot        uint64         `gorm:"NOT NULL"`
+	CreatedTime int64          `gorm:"NOT NULL;index:idx_block_event_created_time;comment:created_time"`
+}
+
+func (*BlockEvent) TableName() string {
+	return "block_event"
+}
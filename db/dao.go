@@ -1,6 +1,8 @@
 package db
 
 import (
+	"time"
+
 	"gorm.io/gorm"
 )
 
@@ -8,7 +10,11 @@ type BlobDao interface {
 	BlockDB
 	BlobDB
 	BundleDB
+	OutboxDB
+	RepairDB
+	BlockEventDB
 	SaveBlockAndBlob(block *Block, blobs []*Blob) error
+	ReplaceBlockAndBlob(block *Block, blobs []*Blob, staleBlobIDs []int64) error
 }
 
 type BlobSvcDB struct {
@@ -24,8 +30,17 @@ func NewBlobSvcDB(db *gorm.DB) BlobDao {
 type BlockDB interface {
 	GetBlock(slot uint64) (*Block, error)
 	GetBlockByRoot(root string) (*Block, error)
+	GetBlockByHash(blockHash string) (*Block, error)
 	GetLatestProcessedBlock() (*Block, error)
+	GetEarliestBlock() (*Block, error)
+	GetLatestVerifiedBlock() (*Block, error)
+	GetLatestBlockWithRoot() (*Block, error)
+	GetEarliestBlockWithRoot() (*Block, error)
+	GetLatestVerifiedBlockWithRoot() (*Block, error)
 	GetEarliestUnverifiedBlock() (*Block, error)
+	GetBlocksBetween(startSlot, endSlot uint64) ([]*Block, error)
+	GetBlocksWithoutHash(limit int) ([]*Block, error)
+	UpdateBlockHash(slot uint64, blockHash string) error
 	UpdateBlockStatus(slot uint64, status Status) error
 	UpdateBlocksStatus(startSlot, endSlot uint64, status Status) error
 }
@@ -42,7 +57,16 @@ func (d *BlobSvcDB) GetBlock(slot uint64) (*Block, error) {
 func (d *BlobSvcDB) GetBlockByRoot(root string) (*Block, error) {
 	block := Block{}
 	err := d.db.Model(Block{}).Where("root = ?", root).Take(&block).Error
-	if err != nil && err != gorm.ErrRecordNotFound {
+	if err != nil {
+		return nil, err
+	}
+	return &block, nil
+}
+
+func (d *BlobSvcDB) GetBlockByHash(blockHash string) (*Block, error) {
+	block := Block{}
+	err := d.db.Model(Block{}).Where("block_hash = ?", blockHash).Take(&block).Error
+	if err != nil {
 		return nil, err
 	}
 	return &block, nil
@@ -57,6 +81,55 @@ func (d *BlobSvcDB) GetLatestProcessedBlock() (*Block, error) {
 	return &block, nil
 }
 
+func (d *BlobSvcDB) GetEarliestBlock() (*Block, error) {
+	block := Block{}
+	err := d.db.Model(Block{}).Order("slot asc").Take(&block).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	return &block, nil
+}
+
+func (d *BlobSvcDB) GetLatestVerifiedBlock() (*Block, error) {
+	block := Block{}
+	err := d.db.Model(Block{}).Where("status = ?", Verified).Order("slot desc").Take(&block).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// GetLatestBlockWithRoot returns the latest ETH block, skipping the empty slots recorded as blocks without root
+func (d *BlobSvcDB) GetLatestBlockWithRoot() (*Block, error) {
+	block := Block{}
+	err := d.db.Model(Block{}).Where("root <> ''").Order("slot desc").Take(&block).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// GetEarliestBlockWithRoot returns the earliest ETH block, skipping the empty slots recorded as blocks without root
+func (d *BlobSvcDB) GetEarliestBlockWithRoot() (*Block, error) {
+	block := Block{}
+	err := d.db.Model(Block{}).Where("root <> ''").Order("slot asc").Take(&block).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	return &block, nil
+}
+
+// GetLatestVerifiedBlockWithRoot returns the latest verified ETH block, skipping the empty slots recorded as blocks
+// without root
+func (d *BlobSvcDB) GetLatestVerifiedBlockWithRoot() (*Block, error) {
+	block := Block{}
+	err := d.db.Model(Block{}).Where("status = ? and root <> ''", Verified).Order("slot desc").Take(&block).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	return &block, nil
+}
+
 func (d *BlobSvcDB) GetEarliestUnverifiedBlock() (*Block, error) {
 	block := Block{}
 	err := d.db.Model(Block{}).Where("status = ?", Processed).Order("slot asc").Take(&block).Error
@@ -66,17 +139,48 @@ func (d *BlobSvcDB) GetEarliestUnverifiedBlock() (*Block, error) {
 	return &block, nil
 }
 
+func (d *BlobSvcDB) GetBlocksBetween(startSlot, endSlot uint64) ([]*Block, error) {
+	blocks := make([]*Block, 0)
+	if err := d.db.Where("slot >= ? and slot <= ?", startSlot, endSlot).Order("slot asc").Find(&blocks).Error; err != nil {
+		return blocks, err
+	}
+	return blocks, nil
+}
+
+// GetBlocksWithoutHash returns the earliest blocks whose hash is not recorded, the BSC blocks archived before schema
+// version 5
+func (d *BlobSvcDB) GetBlocksWithoutHash(limit int) ([]*Block, error) {
+	blocks := make([]*Block, 0)
+	if err := d.db.Where("block_hash = ''").Order("slot asc").Limit(limit).Find(&blocks).Error; err != nil {
+		return blocks, err
+	}
+	return blocks, nil
+}
+
+func (d *BlobSvcDB) UpdateBlockHash(slot uint64, blockHash string) error {
+	return d.db.Model(Block{}).Where("slot = ?", slot).Update("block_hash", blockHash).Error
+}
+
 func (d *BlobSvcDB) UpdateBlockStatus(slot uint64, status Status) error {
-	return d.db.Transaction(func(dbTx *gorm.DB) error {
-		return dbTx.Model(Block{}).Where("slot = ?", slot).Updates(
-			Block{Status: status}).Error
-	})
+	return d.UpdateBlocksStatus(slot, slot, status)
 }
 
+// UpdateBlocksStatus updates the status of the blocks within [startSlot, endSlot], a block event is recorded for each
+// block becoming verified
 func (d *BlobSvcDB) UpdateBlocksStatus(startSlot, endSlot uint64, status Status) error {
 	return d.db.Transaction(func(dbTx *gorm.DB) error {
-		return dbTx.Model(Block{}).Where("slot >= ? and slot <= ?", startSlot, endSlot).Updates(
-			Block{Status: status}).Error
+		var verifiedSlots []uint64
+		if status == Verified {
+			if err := dbTx.Model(Block{}).Where("slot >= ? and slot <= ? and status <> ?", startSlot, endSlot, Verified).
+				Order("slot asc").Pluck("slot", &verifiedSlots).Error; err != nil {
+				return err
+			}
+		}
+		if err := dbTx.Model(Block{}).Where("slot >= ? and slot <= ?", startSlot, endSlot).Updates(
+			Block{Status: status}).Error; err != nil {
+			return err
+		}
+		return createBlockEvents(dbTx, BlockVerified, verifiedSlots...)
 	})
 }
 
@@ -84,6 +188,10 @@ type BlobDB interface {
 	GetBlobByBlockID(slot uint64) ([]*Blob, error)
 	GetBlobByBlockIDAndIndices(slot uint64, indices []int64) ([]*Blob, error)
 	GetBlobBetweenBlocks(startSlot, endSlot uint64) ([]*Blob, error)
+	GetBlobsByVersionedHashes(hashes []string) ([]*Blob, error)
+	GetBlobsByTxHash(txHash string) ([]*Blob, error)
+	GetBlobsByAddress(address string, role AddressRole, offset, limit int) ([]*Blob, error)
+	CountBlobsBetweenBlocks(startSlot, endSlot uint64) (map[uint64]int, error)
 }
 
 func (d *BlobSvcDB) GetBlobByBlockID(slot uint64) ([]*Blob, error) {
@@ -110,11 +218,62 @@ func (d *BlobSvcDB) GetBlobBetweenBlocks(startSlot, endSlot uint64) ([]*Blob, er
 	return blobs, nil
 }
 
+func (d *BlobSvcDB) GetBlobsByVersionedHashes(hashes []string) ([]*Blob, error) {
+	blobs := make([]*Blob, 0)
+	if err := d.db.Where("versioned_hash in (?)", hashes).Order("slot asc, idx asc").Find(&blobs).Error; err != nil {
+		return blobs, err
+	}
+	return blobs, nil
+}
+
+func (d *BlobSvcDB) GetBlobsByTxHash(txHash string) ([]*Blob, error) {
+	blobs := make([]*Blob, 0)
+	if err := d.db.Where("tx_hash = ?", txHash).Order("slot asc, idx asc").Find(&blobs).Error; err != nil {
+		return blobs, err
+	}
+	return blobs, nil
+}
+
+// GetBlobsByAddress returns a page of the blobs sent from or to the address, the latest first
+func (d *BlobSvcDB) GetBlobsByAddress(address string, role AddressRole, offset, limit int) ([]*Blob, error) {
+	blobs := make([]*Blob, 0)
+	query := d.db.Model(Blob{})
+	switch role {
+	case AddressRoleFrom:
+		query = query.Where("from_addr = ?", address)
+	case AddressRoleTo:
+		query = query.Where("to_addr = ?", address)
+	default:
+		query = query.Where("from_addr = ? or to_addr = ?", address, address)
+	}
+	if err := query.Order("slot desc, idx desc").Offset(offset).Limit(limit).Find(&blobs).Error; err != nil {
+		return blobs, err
+	}
+	return blobs, nil
+}
+
+// CountBlobsBetweenBlocks returns the number of blob rows of each block within the range, blocks without blobs are absent
+func (d *BlobSvcDB) CountBlobsBetweenBlocks(startSlot, endSlot uint64) (map[uint64]int, error) {
+	var rows []struct {
+		Slot  uint64
+		Count int
+	}
+	if err := d.db.Model(Blob{}).Select("slot, count(*) as count").Where("slot >= ? and slot <= ?", startSlot, endSlot).
+		Group("slot").Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	counts := make(map[uint64]int, len(rows))
+	for _, row := range rows {
+		counts[row.Slot] = row.Count
+	}
+	return counts, nil
+}
+
 type BundleDB interface {
 	GetBundle(name string) (*Bundle, error)
 	GetLatestFinalizingBundle() (*Bundle, error)
 	CreateBundle(*Bundle) error
-	UpdateBundleStatus(bundleName string, status InnerBundleStatus) error
+	UpdateBundleStatus(bundleName string, status InnerBundleStatus, events ...*Outbox) error
 }
 
 func (d *BlobSvcDB) GetBundle(name string) (*Bundle, error) {
@@ -137,46 +296,190 @@ func (d *BlobSvcDB) GetLatestFinalizingBundle() (*Bundle, error) {
 
 func (d *BlobSvcDB) CreateBundle(b *Bundle) error {
 	return d.db.Transaction(func(dbTx *gorm.DB) error {
-		err := dbTx.Create(b).Error
-		if err != nil && MysqlErrCode(err) == ErrDuplicateEntryCode {
-			return nil
+		return ignoreDuplicate(dbTx, func(tx *gorm.DB) error {
+			return tx.Create(b).Error
+		})
+	})
+}
+
+// UpdateBundleStatus updates the status of the bundle, the outbox events are saved in the same transaction so that an
+// event is recorded if and only if the status is changed
+func (d *BlobSvcDB) UpdateBundleStatus(bundleName string, status InnerBundleStatus, events ...*Outbox) error {
+	return d.db.Transaction(func(dbTx *gorm.DB) error {
+		if err := dbTx.Model(Bundle{}).Where("name = ?", bundleName).Updates(
+			Bundle{Status: status}).Error; err != nil {
+			return err
 		}
-		return err
+		return createOutboxEvents(dbTx, events)
 	})
 }
 
-func (d *BlobSvcDB) UpdateBundleStatus(bundleName string, status InnerBundleStatus) error {
+type OutboxDB interface {
+	CreateOutboxEvents(events []*Outbox) error
+	GetPendingOutboxEvents(now int64, limit int) ([]*Outbox, error)
+	UpdateOutboxEvent(event *Outbox) error
+	DeleteOutboxEventsBefore(createdTime int64) (int64, error)
+}
+
+func (d *BlobSvcDB) CreateOutboxEvents(events []*Outbox) error {
 	return d.db.Transaction(func(dbTx *gorm.DB) error {
-		return dbTx.Model(Bundle{}).Where("name = ?", bundleName).Updates(
-			Bundle{Status: status}).Error
+		return createOutboxEvents(dbTx, events)
 	})
 }
 
-func (d *BlobSvcDB) SaveBlockAndBlob(block *Block, blobs []*Blob) error {
+func (d *BlobSvcDB) GetPendingOutboxEvents(now int64, limit int) ([]*Outbox, error) {
+	events := make([]*Outbox, 0)
+	if err := d.db.Where("status = ? and next_attempt_time <= ?", OutboxPending, now).Order("id asc").Limit(limit).Find(&events).Error; err != nil {
+		return events, err
+	}
+	return events, nil
+}
+
+func (d *BlobSvcDB) UpdateOutboxEvent(event *Outbox) error {
 	return d.db.Transaction(func(dbTx *gorm.DB) error {
-		err := dbTx.Save(block).Error
-		if err != nil && MysqlErrCode(err) != ErrDuplicateEntryCode {
+		return dbTx.Save(event).Error
+	})
+}
+
+// DeleteOutboxEventsBefore prunes the events delivered or given up before createdTime, it returns the number of events
+// deleted. The pending events are kept however old they are.
+func (d *BlobSvcDB) DeleteOutboxEventsBefore(createdTime int64) (int64, error) {
+	result := d.db.Where("status <> ? and created_time < ?", OutboxPending, createdTime).Delete(&Outbox{})
+	return result.RowsAffected, result.Error
+}
+
+func createOutboxEvents(dbTx *gorm.DB, events []*Outbox) error {
+	if len(events) == 0 {
+		return nil
+	}
+	return dbTx.Create(events).Error
+}
+
+type BlockEventDB interface {
+	GetBlockEventsAfter(id int64, limit int) ([]*BlockEvent, error)
+	GetLatestBlockEventID() (int64, error)
+	DeleteBlockEventsBefore(createdTime int64) (int64, error)
+}
+
+// GetBlockEventsAfter returns the events after id in id order
+func (d *BlobSvcDB) GetBlockEventsAfter(id int64, limit int) ([]*BlockEvent, error) {
+	events := make([]*BlockEvent, 0)
+	if err := d.db.Where("id > ?", id).Order("id asc").Limit(limit).Find(&events).Error; err != nil {
+		return events, err
+	}
+	return events, nil
+}
+
+// GetLatestBlockEventID returns the id of the latest event, 0 when there is none
+func (d *BlobSvcDB) GetLatestBlockEventID() (int64, error) {
+	event := BlockEvent{}
+	err := d.db.Model(BlockEvent{}).Order("id desc").Take(&event).Error
+	if err != nil && err != gorm.ErrRecordNotFound {
+		return 0, err
+	}
+	return event.Id, nil
+}
+
+// DeleteBlockEventsBefore prunes the events created before createdTime, it returns the number of events deleted
+func (d *BlobSvcDB) DeleteBlockEventsBefore(createdTime int64) (int64, error) {
+	result := d.db.Where("created_time < ?", createdTime).Delete(&BlockEvent{})
+	return result.RowsAffected, result.Error
+}
+
+func createBlockEvents(dbTx *gorm.DB, eventType BlockEventType, slots ...uint64) error {
+	if len(slots) == 0 {
+		return nil
+	}
+	now := time.Now().Unix()
+	events := make([]*BlockEvent, 0, len(slots))
+	for _, slot := range slots {
+		events = append(events, &BlockEvent{EventType: eventType, Slot: slot, CreatedTime: now})
+	}
+	return dbTx.Create(events).Error
+}
+
+type RepairDB interface {
+	CreateRepairTask(task *RepairTask) (bool, error)
+	GetPendingRepairTasks(limit int) ([]*RepairTask, error)
+	UpdateRepairTask(task *RepairTask) error
+}
+
+// CreateRepairTask queues the task unless the same repair is already pending, it returns whether the task is queued
+func (d *BlobSvcDB) CreateRepairTask(task *RepairTask) (bool, error) {
+	created := false
+	err := d.db.Transaction(func(dbTx *gorm.DB) error {
+		var count int64
+		if err := dbTx.Model(RepairTask{}).Where("kind = ? and start_block_id = ? and end_block_id = ? and bundle_name = ? and status = ?",
+			task.Kind, task.StartBlockID, task.EndBlockID, task.BundleName, RepairPending).Count(&count).Error; err != nil {
 			return err
 		}
-		if len(blobs) != 0 {
-			err = dbTx.Save(blobs).Error
-			if err != nil && MysqlErrCode(err) != ErrDuplicateEntryCode {
+		if count > 0 {
+			return nil
+		}
+		created = true
+		return dbTx.Create(task).Error
+	})
+	return created, err
+}
+
+func (d *BlobSvcDB) GetPendingRepairTasks(limit int) ([]*RepairTask, error) {
+	tasks := make([]*RepairTask, 0)
+	if err := d.db.Where("status = ?", RepairPending).Order("id asc").Limit(limit).Find(&tasks).Error; err != nil {
+		return tasks, err
+	}
+	return tasks, nil
+}
+
+func (d *BlobSvcDB) UpdateRepairTask(task *RepairTask) error {
+	return d.db.Transaction(func(dbTx *gorm.DB) error {
+		return dbTx.Save(task).Error
+	})
+}
+
+// SaveBlockAndBlob saves the block and its blobs along with a block event, the event is only recorded for a block not
+// saved before
+func (d *BlobSvcDB) SaveBlockAndBlob(block *Block, blobs []*Blob) error {
+	return d.db.Transaction(func(dbTx *gorm.DB) error {
+		return saveBlockAndBlob(dbTx, block, blobs)
+	})
+}
+
+// ReplaceBlockAndBlob saves the block and its blobs over the recorded ones, the blobs recorded but no longer part of the
+// block are deleted in the same transaction
+func (d *BlobSvcDB) ReplaceBlockAndBlob(block *Block, blobs []*Blob, staleBlobIDs []int64) error {
+	return d.db.Transaction(func(dbTx *gorm.DB) error {
+		if len(staleBlobIDs) != 0 {
+			if err := dbTx.Where("id in (?)", staleBlobIDs).Delete(&Blob{}).Error; err != nil {
 				return err
 			}
 		}
-		return nil
+		return saveBlockAndBlob(dbTx, block, blobs)
 	})
 }
 
-func AutoMigrateDB(db *gorm.DB) {
-	var err error
-	if err = db.AutoMigrate(&Bundle{}); err != nil {
-		panic(err)
+// saveBlockAndBlob saves the block and its blobs, the block is only archived by the syncer the first time it is saved.
+// A block saved over its record, as the calibrated ones are, or already recorded under its slot keeps its blobs saved
+// but records no event.
+func saveBlockAndBlob(dbTx *gorm.DB, block *Block, blobs []*Blob) error {
+	archived := block.Id == 0
+	err := dbTx.Transaction(func(tx *gorm.DB) error {
+		return tx.Save(block).Error
+	})
+	if IsDuplicateKeyErr(err) {
+		archived = false
+	} else if err != nil {
+		return err
 	}
-	if err = db.AutoMigrate(&Block{}); err != nil {
-		panic(err)
+	if len(blobs) != 0 {
+		err = ignoreDuplicate(dbTx, func(tx *gorm.DB) error {
+			return tx.Save(blobs).Error
+		})
+		if err != nil {
+			return err
+		}
 	}
-	if err = db.AutoMigrate(&Blob{}); err != nil {
-		panic(err)
+	if !archived {
+		return nil
 	}
+	return createBlockEvents(dbTx, BlockArchived, block.Slot)
 }
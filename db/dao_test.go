@@ -0,0 +1,272 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// newTestDB opens a SQLite DB migrated to the latest schema version, the way the syncer and the api server open it
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "blob-hub.db")), &gorm.Config{
+		Logger:         logger.Default.LogMode(logger.Silent),
+		TranslateError: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = MigrateUp(db, LatestSchemaVersion()); err != nil {
+		t.Fatal(err)
+	}
+	return db
+}
+
+func TestUpdateBundleStatusRecordsOutboxEvents(t *testing.T) {
+	db := newTestDB(t)
+	dao := NewBlobSvcDB(db)
+	if err := dao.CreateBundle(&Bundle{Name: "blobs_s1_e10", Status: Finalizing, CreatedTime: time.Now().Unix()}); err != nil {
+		t.Fatal(err)
+	}
+	newEvent := func() *Outbox {
+		return &Outbox{Endpoint: "http://localhost/hook", EventType: "bundle_finalized", Payload: "{}", CreatedTime: time.Now().Unix()}
+	}
+
+	if err := dao.UpdateBundleStatus("blobs_s1_e10", Finalized, newEvent(), newEvent()); err != nil {
+		t.Fatal(err)
+	}
+	bundle, err := dao.GetBundle("blobs_s1_e10")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bundle.Status != Finalized {
+		t.Fatalf("bundle status = %d, want %d", bundle.Status, Finalized)
+	}
+	var count int64
+	if err = db.Model(Outbox{}).Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Fatalf("outbox events = %d, want 2", count)
+	}
+
+	// an event failing to save rolls the status change back
+	conflicting := newEvent()
+	conflicting.Id = 1
+	if err = dao.UpdateBundleStatus("blobs_s1_e10", Sealed, conflicting); err == nil {
+		t.Fatal("UpdateBundleStatus succeeded with a conflicting event")
+	}
+	if bundle, err = dao.GetBundle("blobs_s1_e10"); err != nil {
+		t.Fatal(err)
+	}
+	if bundle.Status != Finalized {
+		t.Fatalf("bundle status = %d after a failed update, want %d", bundle.Status, Finalized)
+	}
+	if err = db.Model(Outbox{}).Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Fatalf("outbox events = %d after a failed update, want 2", count)
+	}
+}
+
+func TestDeleteOutboxEventsBefore(t *testing.T) {
+	db := newTestDB(t)
+	dao := NewBlobSvcDB(db)
+	now := time.Now().Unix()
+	events := []*Outbox{
+		{Endpoint: "a", EventType: "e", Payload: "{}", Status: OutboxDelivered, CreatedTime: now - 100},
+		{Endpoint: "a", EventType: "e", Payload: "{}", Status: OutboxFailed, CreatedTime: now - 100},
+		{Endpoint: "a", EventType: "e", Payload: "{}", Status: OutboxPending, CreatedTime: now - 100},
+		{Endpoint: "a", EventType: "e", Payload: "{}", Status: OutboxDelivered, CreatedTime: now},
+	}
+	if err := dao.CreateOutboxEvents(events); err != nil {
+		t.Fatal(err)
+	}
+	deleted, err := dao.DeleteOutboxEventsBefore(now - 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 2 {
+		t.Fatalf("deleted = %d, want 2", deleted)
+	}
+	var left []*Outbox
+	if err = db.Order("id asc").Find(&left).Error; err != nil {
+		t.Fatal(err)
+	}
+	if len(left) != 2 || left[0].Id != events[2].Id || left[1].Id != events[3].Id {
+		t.Fatalf("left events = %+v, want the pending and the recent ones", left)
+	}
+}
+
+func newTestBlob(slot uint64, idx int) *Blob {
+	return &Blob{
+		Name:          fmt.Sprintf("blob_h%d_i%d", slot, idx),
+		TxHash:        "tx",
+		ToAddr:        "to",
+		VersionedHash: fmt.Sprintf("hash_%d_%d", slot, idx),
+		Slot:          slot,
+		Idx:           idx,
+	}
+}
+
+func countBlobs(t *testing.T, db *gorm.DB, slot uint64) int64 {
+	t.Helper()
+	var count int64
+	if err := db.Model(Blob{}).Where("slot = ?", slot).Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+	return count
+}
+
+func TestReplaceBlockAndBlob(t *testing.T) {
+	db := newTestDB(t)
+	dao := NewBlobSvcDB(db)
+	block := &Block{Slot: 10, Root: "root", BundleName: "blobs_s1_e10", BlobCount: 3}
+	blobs := []*Blob{newTestBlob(10, 0), newTestBlob(10, 1), newTestBlob(10, 2)}
+	if err := dao.SaveBlockAndBlob(block, blobs); err != nil {
+		t.Fatal(err)
+	}
+
+	// the blob re-fetched from the chain takes over the first row, the other two are stale
+	replaced := &Block{Id: block.Id, Slot: 10, Root: "root", BundleName: "blobs_s1_e10_calibrated_1", BlobCount: 1}
+	replacedBlob := newTestBlob(10, 0)
+	replacedBlob.Id = blobs[0].Id
+	replacedBlob.TxHash = "new_tx"
+
+	// a failing save keeps the stale blobs
+	failSave := func(tx *gorm.DB) {
+		if tx.Statement.Table == "blob" {
+			_ = tx.AddError(errors.New("injected failure"))
+		}
+	}
+	if err := db.Callback().Update().Before("gorm:update").Register("test:fail_update", failSave); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Callback().Create().Before("gorm:create").Register("test:fail_create", failSave); err != nil {
+		t.Fatal(err)
+	}
+	if err := dao.ReplaceBlockAndBlob(replaced, []*Blob{replacedBlob}, []int64{blobs[1].Id, blobs[2].Id}); err == nil {
+		t.Fatal("ReplaceBlockAndBlob succeeded with a failing save")
+	}
+	if count := countBlobs(t, db, 10); count != 3 {
+		t.Fatalf("blobs = %d after a failed replace, want 3", count)
+	}
+	if err := db.Callback().Update().Remove("test:fail_update"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Callback().Create().Remove("test:fail_create"); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := dao.ReplaceBlockAndBlob(replaced, []*Blob{replacedBlob}, []int64{blobs[1].Id, blobs[2].Id}); err != nil {
+		t.Fatal(err)
+	}
+	saved, err := dao.GetBlobByBlockID(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(saved) != 1 || saved[0].Id != blobs[0].Id || saved[0].TxHash != "new_tx" {
+		t.Fatalf("blobs = %+v, want the replaced blob only", saved)
+	}
+	savedBlock, err := dao.GetBlock(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if savedBlock.BundleName != "blobs_s1_e10_calibrated_1" || savedBlock.BlobCount != 1 {
+		t.Fatalf("block = %+v, want the replaced block", savedBlock)
+	}
+}
+
+func countBlockEvents(t *testing.T, db *gorm.DB, slot uint64) int64 {
+	t.Helper()
+	var count int64
+	if err := db.Model(BlockEvent{}).Where("slot = ? and event_type = ?", slot, BlockArchived).Count(&count).Error; err != nil {
+		t.Fatal(err)
+	}
+	return count
+}
+
+func TestSaveBlockAndBlobArchivesOnce(t *testing.T) {
+	db := newTestDB(t)
+	dao := NewBlobSvcDB(db)
+	block := &Block{Slot: 10, Root: "root", BundleName: "blobs_s1_e10"}
+	if err := dao.SaveBlockAndBlob(block, nil); err != nil {
+		t.Fatal(err)
+	}
+	if count := countBlockEvents(t, db, 10); count != 1 {
+		t.Fatalf("block events = %d, want 1", count)
+	}
+
+	// the blobs of a block recorded already are still saved
+	duplicate := &Block{Slot: 10, Root: "root", BundleName: "blobs_s1_e10", BlobCount: 2}
+	if err := dao.SaveBlockAndBlob(duplicate, []*Blob{newTestBlob(10, 0), newTestBlob(10, 1)}); err != nil {
+		t.Fatal(err)
+	}
+	if count := countBlobs(t, db, 10); count != 2 {
+		t.Fatalf("blobs = %d after saving a recorded block, want 2", count)
+	}
+	if count := countBlockEvents(t, db, 10); count != 1 {
+		t.Fatalf("block events = %d after saving a recorded block, want 1", count)
+	}
+
+	// a calibrated block is saved over its record without being archived again
+	calibrated := &Block{Id: block.Id, Slot: 10, Root: "root", BundleName: "blobs_s1_e10_calibrated_1", BlobCount: 2}
+	if err := dao.ReplaceBlockAndBlob(calibrated, nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if count := countBlockEvents(t, db, 10); count != 1 {
+		t.Fatalf("block events = %d after a calibrated save, want 1", count)
+	}
+	saved, err := dao.GetBlock(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.BundleName != "blobs_s1_e10_calibrated_1" {
+		t.Fatalf("bundle name = %s, want the calibrated bundle", saved.BundleName)
+	}
+}
+
+func TestGetBlocksWithoutHash(t *testing.T) {
+	db := newTestDB(t)
+	dao := NewBlobSvcDB(db)
+	for _, block := range []*Block{{Slot: 3}, {Slot: 1}, {Slot: 2, BlockHash: "hash2"}, {Slot: 4}} {
+		if err := dao.SaveBlockAndBlob(block, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	slots := func(limit int) []uint64 {
+		t.Helper()
+		blocks, err := dao.GetBlocksWithoutHash(limit)
+		if err != nil {
+			t.Fatal(err)
+		}
+		result := make([]uint64, 0, len(blocks))
+		for _, block := range blocks {
+			result = append(result, block.Slot)
+		}
+		return result
+	}
+	if got := slots(2); fmt.Sprint(got) != "[1 3]" {
+		t.Fatalf("blocks without hash = %v, want [1 3]", got)
+	}
+	if err := dao.UpdateBlockHash(1, "hash1"); err != nil {
+		t.Fatal(err)
+	}
+	if got := slots(10); fmt.Sprint(got) != "[3 4]" {
+		t.Fatalf("blocks without hash = %v, want [3 4]", got)
+	}
+	block, err := dao.GetBlockByHash("hash1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if block.Slot != 1 {
+		t.Fatalf("block of hash1 = %d, want 1", block.Slot)
+	}
+}
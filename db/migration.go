@@ -0,0 +1,374 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// Migration moves the schema from Version-1 to Version and back. A migration must not refer to the models in this
+// package, which keep changing, but to snapshots of the tables as of its version.
+type Migration struct {
+	Version uint
+	Name    string
+	Up      func(tx *gorm.DB) error
+	Down    func(tx *gorm.DB) error
+}
+
+// migrations are ordered by version without gaps, append new ones and never edit an applied one
+var migrations = []*Migration{
+	{
+		Version: 1,
+		Name:    "baseline",
+		// AutoMigrate creates the tables of a fresh DB and adopts the tables of a DB set up before versioned migrations
+		Up: func(tx *gorm.DB) error {
+			return tx.AutoMigrate(&bundleV1{}, &blockV1{}, &blobV1{}, &outboxV1{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&outboxV1{}, &blobV1{}, &blockV1{}, &bundleV1{})
+		},
+	},
+	{
+		Version: 2,
+		Name:    "repair_task",
+		Up: func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&repairTaskV2{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&repairTaskV2{})
+		},
+	},
+	{
+		Version: 3,
+		Name:    "blob_versioned_hash_index",
+		// the column is narrowed first, as mysql can't index a text column
+		Up: func(tx *gorm.DB) error {
+			if err := tx.Migrator().AlterColumn(&blobV3{}, "VersionedHash"); err != nil {
+				return err
+			}
+			return tx.Migrator().CreateIndex(&blobV3{}, "idx_blob_versioned_hash")
+		},
+		Down: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropIndex(&blobV3{}, "idx_blob_versioned_hash"); err != nil {
+				return err
+			}
+			return tx.Migrator().AlterColumn(&blobV1{}, "VersionedHash")
+		},
+	},
+	{
+		Version: 4,
+		Name:    "blob_from_address",
+		Up: func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&blobV4{}, "FromAddr"); err != nil {
+				return err
+			}
+			return tx.Migrator().CreateIndex(&blobV4{}, "idx_blob_from_address")
+		},
+		Down: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropIndex(&blobV4{}, "idx_blob_from_address"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropColumn(&blobV4{}, "FromAddr")
+		},
+	},
+	{
+		Version: 5,
+		Name:    "block_hash",
+		Up: func(tx *gorm.DB) error {
+			if err := tx.Migrator().AddColumn(&blockV5{}, "BlockHash"); err != nil {
+				return err
+			}
+			return tx.Migrator().CreateIndex(&blockV5{}, "idx_block_block_hash")
+		},
+		Down: func(tx *gorm.DB) error {
+			if err := tx.Migrator().DropIndex(&blockV5{}, "idx_block_block_hash"); err != nil {
+				return err
+			}
+			return tx.Migrator().DropColumn(&blockV5{}, "BlockHash")
+		},
+	},
+	{
+		Version: 6,
+		Name:    "block_event",
+		Up: func(tx *gorm.DB) error {
+			return tx.Migrator().CreateTable(&blockEventV6{})
+		},
+		Down: func(tx *gorm.DB) error {
+			return tx.Migrator().DropTable(&blockEventV6{})
+		},
+	},
+}
+
+const (
+	// SyncerMinSchemaVersion and SyncerMaxSchemaVersion bound the schema the syncer can write to
+	SyncerMinSchemaVersion = 6
+	SyncerMaxSchemaVersion = 6
+	// ServerMinSchemaVersion and ServerMaxSchemaVersion bound the schema the api server can read from
+	ServerMinSchemaVersion = 6
+	ServerMaxSchemaVersion = 6
+)
+
+// LatestSchemaVersion returns the version the migrations bring a DB up to
+func LatestSchemaVersion() uint {
+	return migrations[len(migrations)-1].Version
+}
+
+// GetSchemaVersion returns the schema version of the DB, 0 when no migration has been applied
+func GetSchemaVersion(db *gorm.DB) (uint, error) {
+	if !db.Migrator().HasTable(&SchemaVersion{}) {
+		return 0, nil
+	}
+	var version SchemaVersion
+	err := db.Model(SchemaVersion{}).Order("version desc").Take(&version).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return version.Version, nil
+}
+
+// GetAppliedMigrations returns the applied migrations in version order
+func GetAppliedMigrations(db *gorm.DB) ([]*SchemaVersion, error) {
+	versions := make([]*SchemaVersion, 0)
+	if !db.Migrator().HasTable(&SchemaVersion{}) {
+		return versions, nil
+	}
+	if err := db.Order("version asc").Find(&versions).Error; err != nil {
+		return versions, err
+	}
+	return versions, nil
+}
+
+// CheckSchemaVersion returns an error unless the schema version of the DB is within [min, max]
+func CheckSchemaVersion(db *gorm.DB, min, max uint) error {
+	version, err := GetSchemaVersion(db)
+	if err != nil {
+		return err
+	}
+	if version < min {
+		return fmt.Errorf("schema version %d is older than the minimum supported version %d, run the migrate command first", version, min)
+	}
+	if version > max {
+		return fmt.Errorf("schema version %d is newer than the maximum supported version %d, upgrade the binary", version, max)
+	}
+	return nil
+}
+
+// MigrateUp applies the migrations after the current schema version up to and including target
+func MigrateUp(db *gorm.DB, target uint) error {
+	if target > LatestSchemaVersion() {
+		return fmt.Errorf("target version %d is newer than the latest version %d", target, LatestSchemaVersion())
+	}
+	if err := db.AutoMigrate(&SchemaVersion{}); err != nil {
+		return err
+	}
+	current, err := GetSchemaVersion(db)
+	if err != nil {
+		return err
+	}
+	for _, m := range migrations {
+		if m.Version <= current || m.Version > target {
+			continue
+		}
+		err = db.Transaction(func(tx *gorm.DB) error {
+			if err := m.Up(tx); err != nil {
+				return err
+			}
+			return tx.Create(&SchemaVersion{Version: m.Version, Name: m.Name, AppliedTime: time.Now().Unix()}).Error
+		})
+		if err != nil {
+			return fmt.Errorf("failed to apply migration %d %s: %w", m.Version, m.Name, err)
+		}
+	}
+	return nil
+}
+
+// MigrateDown reverts the applied migrations after target, from the newest one
+func MigrateDown(db *gorm.DB, target uint) error {
+	current, err := GetSchemaVersion(db)
+	if err != nil {
+		return err
+	}
+	for i := len(migrations) - 1; i >= 0; i-- {
+		m := migrations[i]
+		if m.Version > current || m.Version <= target {
+			continue
+		}
+		err = db.Transaction(func(tx *gorm.DB) error {
+			if err := m.Down(tx); err != nil {
+				return err
+			}
+			return tx.Where("version = ?", m.Version).Delete(&SchemaVersion{}).Error
+		})
+		if err != nil {
+			return fmt.Errorf("failed to revert migration %d %s: %w", m.Version, m.Name, err)
+		}
+	}
+	return nil
+}
+
+// the tables as of schema version 1
+
+type bundleV1 struct {
+	Id          int64
+	Name        string            `gorm:"NOT NULL;uniqueIndex:idx_bundle_name;size:64"`
+	Status      InnerBundleStatus `gorm:"NOT NULL"`
+	Calibrated  bool
+	CreatedTime int64 `gorm:"NOT NULL;comment:created_time"`
+}
+
+func (*bundleV1) TableName() string {
+	return "bundle"
+}
+
+type blockV1 struct {
+	Id            int64
+	Root          string `gorm:"NOT NULL;index:idx_block_root;size:64"`
+	ParentRoot    string
+	StateRoot     string
+	BodyRoot      string
+	ProposerIndex uint64
+	Signature     string
+	Slot          uint64 `gorm:"NOT NULL;uniqueIndex:idx_block_slot"`
+	ELBlockHeight uint64
+	BlobCount     int
+	BundleName    string `gorm:"NOT NULL"`
+	Status        Status `gorm:"index:idx_block_status"`
+}
+
+func (*blockV1) TableName() string {
+	return "block"
+}
+
+type blobV1 struct {
+	Id                       int64
+	Name                     string `gorm:"NOT NULL;uniqueIndex:idx_blob_name;size:96"`
+	TxHash                   string `gorm:"NOT NULL;index:idx_blob_tx_hash"`
+	ToAddr                   string `gorm:"NOT NULL;index:idx_blob_to_address"`
+	VersionedHash            string `gorm:"NOT NULL"`
+	Slot                     uint64 `gorm:"NOT NULL;index:idx_blob_slot_index"`
+	Idx                      int    `gorm:"NOT NULL;index:idx_blob_slot_idx"`
+	TxIndex                  int    `gorm:"comment:txIndex"`
+	KzgCommitment            string `gorm:"NOT NULL"`
+	KzgProof                 string `gorm:"NOT NULL"`
+	CommitmentInclusionProof string `gorm:"NOT NULL"`
+}
+
+func (*blobV1) TableName() string {
+	return "blob"
+}
+
+type outboxV1 struct {
+	Id              int64
+	Endpoint        string       `gorm:"NOT NULL;size:512"`
+	EventType       string       `gorm:"NOT NULL;size:64"`
+	Payload         string       `gorm:"NOT NULL;type:text"`
+	Status          OutboxStatus `gorm:"NOT NULL;index:idx_outbox_status_next_attempt,priority:1"`
+	Attempts        int          `gorm:"NOT NULL"`
+	NextAttemptTime int64        `gorm:"NOT NULL;index:idx_outbox_status_next_attempt,priority:2"`
+	LastError       string       `gorm:"type:text"`
+	CreatedTime     int64        `gorm:"NOT NULL;comment:created_time"`
+}
+
+func (*outboxV1) TableName() string {
+	return "outbox"
+}
+
+// the tables added in schema version 2
+
+type repairTaskV2 struct {
+	Id           int64
+	Kind         RepairKind   `gorm:"NOT NULL;size:32"`
+	StartBlockID uint64       `gorm:"NOT NULL"`
+	EndBlockID   uint64       `gorm:"NOT NULL"`
+	BundleName   string       `gorm:"NOT NULL;size:96"`
+	Status       RepairStatus `gorm:"NOT NULL;index:idx_repair_task_status"`
+	Attempts     int          `gorm:"NOT NULL"`
+	LastError    string       `gorm:"type:text"`
+	CreatedTime  int64        `gorm:"NOT NULL;comment:created_time"`
+	UpdatedTime  int64        `gorm:"NOT NULL;comment:updated_time"`
+}
+
+func (*repairTaskV2) TableName() string {
+	return "repair_task"
+}
+
+// the tables changed in schema version 3
+
+type blobV3 struct {
+	Id                       int64
+	Name                     string `gorm:"NOT NULL;uniqueIndex:idx_blob_name;size:96"`
+	TxHash                   string `gorm:"NOT NULL;index:idx_blob_tx_hash"`
+	ToAddr                   string `gorm:"NOT NULL;index:idx_blob_to_address"`
+	VersionedHash            string `gorm:"NOT NULL;index:idx_blob_versioned_hash;size:66"`
+	Slot                     uint64 `gorm:"NOT NULL;index:idx_blob_slot_index"`
+	Idx                      int    `gorm:"NOT NULL;index:idx_blob_slot_idx"`
+	TxIndex                  int    `gorm:"comment:txIndex"`
+	KzgCommitment            string `gorm:"NOT NULL"`
+	KzgProof                 string `gorm:"NOT NULL"`
+	CommitmentInclusionProof string `gorm:"NOT NULL"`
+}
+
+func (*blobV3) TableName() string {
+	return "blob"
+}
+
+// the tables changed in schema version 4
+
+type blobV4 struct {
+	Id                       int64
+	Name                     string `gorm:"NOT NULL;uniqueIndex:idx_blob_name;size:96"`
+	TxHash                   string `gorm:"NOT NULL;index:idx_blob_tx_hash"`
+	ToAddr                   string `gorm:"NOT NULL;index:idx_blob_to_address"`
+	FromAddr                 string `gorm:"NOT NULL;default:'';index:idx_blob_from_address;size:42"`
+	VersionedHash            string `gorm:"NOT NULL;index:idx_blob_versioned_hash;size:66"`
+	Slot                     uint64 `gorm:"NOT NULL;index:idx_blob_slot_index"`
+	Idx                      int    `gorm:"NOT NULL;index:idx_blob_slot_idx"`
+	TxIndex                  int    `gorm:"comment:txIndex"`
+	KzgCommitment            string `gorm:"NOT NULL"`
+	KzgProof                 string `gorm:"NOT NULL"`
+	CommitmentInclusionProof string `gorm:"NOT NULL"`
+}
+
+func (*blobV4) TableName() string {
+	return "blob"
+}
+
+// the tables changed in schema version 5
+
+type blockV5 struct {
+	Id            int64
+	Root          string `gorm:"NOT NULL;index:idx_block_root;size:64"`
+	ParentRoot    string
+	StateRoot     string
+	BodyRoot      string
+	ProposerIndex uint64
+	Signature     string
+	Slot          uint64 `gorm:"NOT NULL;uniqueIndex:idx_block_slot"`
+	ELBlockHeight uint64
+	BlockHash     string `gorm:"NOT NULL;default:'';index:idx_block_block_hash;size:64"`
+	BlobCount     int
+	BundleName    string `gorm:"NOT NULL"`
+	Status        Status `gorm:"index:idx_block_status"`
+}
+
+func (*blockV5) TableName() string {
+	return "block"
+}
+
+// the tables added in schema version 6
+
+type blockEventV6 struct {
+	Id          int64
+	EventType   BlockEventType `gorm:"NOT NULL;size:32"`
+	Slot        uint64         `gorm:"NOT NULL"`
+	CreatedTime int64          `gorm:"NOT NULL;index:idx_block_event_created_time;comment:created_time"`
+}
+
+func (*blockEventV6) TableName() string {
+	return "block_event"
+}
@@ -0,0 +1,26 @@
+package db
+
+type OutboxStatus int
+
+const (
+	OutboxPending   OutboxStatus = 0
+	OutboxDelivered OutboxStatus = 1
+	OutboxFailed    OutboxStatus = 2 // the delivery is given up after the max attempts
+)
+
+// Outbox is an event waiting to be delivered to a webhook endpoint, an event is stored once per subscribed endpoint.
+type Outbox struct {
+	Id              int64
+	Endpoint        string       `gorm:"NOT NULL;size:512"`
+	EventType       string       `gorm:"NOT NULL;size:64"`
+	Payload         string       `gorm:"NOT NULL;type:text"`
+	Status          OutboxStatus `gorm:"NOT NULL;index:idx_outbox_status_next_attempt,priority:1"`
+	Attempts        int          `gorm:"NOT NULL"`
+	NextAttemptTime int64        `gorm:"NOT NULL;index:idx_outbox_status_next_attempt,priority:2"`
+	LastError       string       `gorm:"type:text"`
+	CreatedTime     int64        `gorm:"NOT NULL;comment:created_time"`
+}
+
+func (*Outbox) TableName() string {
+	return "outbox"
+}
@@ -0,0 +1,34 @@
+package db
+
+type RepairKind string
+
+const (
+	RepairFillBlocks    RepairKind = "fill_blocks"    // the blocks within [StartBlockID, EndBlockID] are missing and synced again
+	RepairRebuildBundle RepairKind = "rebuild_bundle" // the blocks of BundleName are re-uploaded as a calibrated bundle
+)
+
+type RepairStatus int
+
+const (
+	RepairPending RepairStatus = 0
+	RepairDone    RepairStatus = 1
+	RepairFailed  RepairStatus = 2 // the repair is given up after the max attempts
+)
+
+// RepairTask is a problem found by the scanner, queued to be repaired by the syncer.
+type RepairTask struct {
+	Id           int64
+	Kind         RepairKind   `gorm:"NOT NULL;size:32"`
+	StartBlockID uint64       `gorm:"NOT NULL"`
+	EndBlockID   uint64       `gorm:"NOT NULL"`
+	BundleName   string       `gorm:"NOT NULL;size:96"`
+	Status       RepairStatus `gorm:"NOT NULL;index:idx_repair_task_status"`
+	Attempts     int          `gorm:"NOT NULL"`
+	LastError    string       `gorm:"type:text"`
+	CreatedTime  int64        `gorm:"NOT NULL;comment:created_time"`
+	UpdatedTime  int64        `gorm:"NOT NULL;comment:updated_time"`
+}
+
+func (*RepairTask) TableName() string {
+	return "repair_task"
+}
@@ -0,0 +1,12 @@
+package db
+
+// SchemaVersion records an applied migration, the schema version of a DB is the highest applied one.
+type SchemaVersion struct {
+	Version     uint   `gorm:"primaryKey;autoIncrement:false"`
+	Name        string `gorm:"NOT NULL;size:128"`
+	AppliedTime int64  `gorm:"NOT NULL;comment:applied_time"`
+}
+
+func (*SchemaVersion) TableName() string {
+	return "schema_version"
+}
@@ -1,17 +1,23 @@
 package db
 
 import (
-	"github.com/go-sql-driver/mysql"
-)
+	"errors"
 
-var (
-	ErrDuplicateEntryCode = 1062
+	"gorm.io/gorm"
 )
 
-func MysqlErrCode(err error) int {
-	mysqlErr, ok := err.(*mysql.MySQLError)
-	if !ok {
-		return 0
+// IsDuplicateKeyErr reports whether err is a unique constraint violation, the DB has to be opened with
+// TranslateError so that mysql, postgres and sqlite errors all surface as gorm.ErrDuplicatedKey
+func IsDuplicateKeyErr(err error) bool {
+	return errors.Is(err, gorm.ErrDuplicatedKey)
+}
+
+// ignoreDuplicate runs write in a savepoint and drops a duplicate key error, rolling back to the savepoint keeps the
+// enclosing transaction usable, postgres aborts the whole transaction on any failed statement otherwise
+func ignoreDuplicate(dbTx *gorm.DB, write func(tx *gorm.DB) error) error {
+	err := dbTx.Transaction(write)
+	if IsDuplicateKeyErr(err) {
+		return nil
 	}
-	return int(mysqlErr.Number)
+	return err
 }
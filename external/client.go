@@ -6,13 +6,16 @@ import (
 	"strconv"
 
 	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 
 	"github.com/bnb-chain/blob-hub/config"
+	"github.com/bnb-chain/blob-hub/external/cmn"
 	"github.com/bnb-chain/blob-hub/external/eth"
+	"github.com/bnb-chain/blob-hub/metrics"
 	types2 "github.com/bnb-chain/blob-hub/types"
 	"github.com/bnb-chain/blob-hub/util"
 )
@@ -22,6 +25,7 @@ const BSCBlockConfirmNum = 3
 type IClient interface {
 	GetBlob(ctx context.Context, blockID uint64) ([]*types2.GeneralSideCar, error)
 	GetBlockHeader(ctx context.Context, height uint64) (*types.Header, error)
+	GetBlockHeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error)
 	GetFinalizedBlockNum(ctx context.Context) (uint64, error)
 	BlockByNumber(ctx context.Context, int2 *big.Int) (*types.Block, error)
 
@@ -39,13 +43,16 @@ type Client struct {
 }
 
 func NewClient(cfg *config.SyncerConfig) IClient {
-	ethClient, err := ethclient.Dial(cfg.RPCAddrs[0])
-	if err != nil {
-		panic("new eth client error")
-	}
 	cli := &Client{
-		cfg:       cfg,
-		ethClient: ethClient,
+		cfg: cfg,
+	}
+	// the live chain fallback of the server reads ETH blobs from the beacon nodes only
+	if len(cfg.RPCAddrs) != 0 {
+		ethClient, err := ethclient.Dial(cfg.RPCAddrs[0])
+		if err != nil {
+			panic("new eth client error")
+		}
+		cli.ethClient = ethClient
 	}
 	if cfg.Chain == config.BSC {
 		rpcClient, err := rpc.DialContext(context.Background(), cfg.RPCAddrs[0])
@@ -70,6 +77,7 @@ func (c *Client) GetBlob(ctx context.Context, blockID uint64) ([]*types2.General
 		number := rpc.BlockNumberOrHashWithNumber(rpc.BlockNumber(blockID))
 		err := c.rpcClient.CallContext(ctx, &txSidecars, "eth_getBlobSidecars", number.String())
 		if err != nil {
+			c.observeError(c.cfg.RPCAddrs[0], "eth_getBlobSidecars", err)
 			return nil, err
 		}
 		if txSidecars == nil {
@@ -101,6 +109,7 @@ func (c *Client) GetBlob(ctx context.Context, blockID uint64) ([]*types2.General
 	}
 	ethSidecars, err := c.beaconClient.GetBlob(ctx, blockID)
 	if err != nil {
+		c.observeError(c.cfg.BeaconRPCAddrs[0], "blob_sidecars", err)
 		return nil, err
 	}
 	for _, sidecar := range ethSidecars {
@@ -116,6 +125,16 @@ func (c *Client) GetBlob(ctx context.Context, blockID uint64) ([]*types2.General
 func (c *Client) GetBlockHeader(ctx context.Context, height uint64) (*types.Header, error) {
 	header, err := c.ethClient.HeaderByNumber(ctx, big.NewInt(int64(height)))
 	if err != nil {
+		c.observeError(c.cfg.RPCAddrs[0], "eth_getHeaderByNumber", err)
+		return nil, err
+	}
+	return header, nil
+}
+
+func (c *Client) GetBlockHeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	header, err := c.ethClient.HeaderByHash(ctx, hash)
+	if err != nil {
+		c.observeError(c.cfg.RPCAddrs[0], "eth_getHeaderByHash", err)
 		return nil, err
 	}
 	return header, nil
@@ -124,6 +143,7 @@ func (c *Client) GetBlockHeader(ctx context.Context, height uint64) (*types.Head
 func (c *Client) GetFinalizedBlockNum(ctx context.Context) (uint64, error) {
 	var head *types.Header
 	if err := c.rpcClient.CallContext(ctx, &head, "eth_getFinalizedHeader", BSCBlockConfirmNum); err != nil {
+		c.observeError(c.cfg.RPCAddrs[0], "eth_getFinalizedHeader", err)
 		return 0, err
 	}
 	if head == nil || head.Number == nil {
@@ -133,19 +153,36 @@ func (c *Client) GetFinalizedBlockNum(ctx context.Context) (uint64, error) {
 }
 
 func (c *Client) BlockByNumber(ctx context.Context, int2 *big.Int) (*types.Block, error) {
-	return c.ethClient.BlockByNumber(ctx, int2)
+	block, err := c.ethClient.BlockByNumber(ctx, int2)
+	c.observeError(c.cfg.RPCAddrs[0], "eth_getBlockByNumber", err)
+	return block, err
 }
 
 func (c *Client) GetLatestBeaconBlock(ctx context.Context) (*structs.GetBlockV2Response, error) {
-	return c.beaconClient.GetLatestBeaconBlock(ctx)
+	block, err := c.beaconClient.GetLatestBeaconBlock(ctx)
+	c.observeError(c.cfg.BeaconRPCAddrs[0], "latest_block", err)
+	return block, err
 }
 
 func (c *Client) GetBeaconHeader(ctx context.Context, slotNumber uint64) (*structs.GetBlockHeaderResponse, error) {
-	return c.beaconClient.GetBeaconHeader(ctx, slotNumber)
+	header, err := c.beaconClient.GetBeaconHeader(ctx, slotNumber)
+	c.observeError(c.cfg.BeaconRPCAddrs[0], "headers", err)
+	return header, err
 }
 
 func (c *Client) GetBeaconBlock(ctx context.Context, slotNumber uint64) (*structs.GetBlockV2Response, error) {
-	return c.beaconClient.GetBeaconBlock(ctx, slotNumber)
+	block, err := c.beaconClient.GetBeaconBlock(ctx, slotNumber)
+	c.observeError(c.cfg.BeaconRPCAddrs[0], "blocks", err)
+	return block, err
+}
+
+// observeError counts the failed calls to the chain endpoint, a block not found is expected for skipped slots and
+// not counted.
+func (c *Client) observeError(endpoint, method string, err error) {
+	if err == nil || cmn.IsNotFound(err) {
+		return
+	}
+	metrics.ObserveRPCError(endpoint, method)
 }
 
 // BSCBlobSidecar is a sidecar struct for BSC
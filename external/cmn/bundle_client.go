@@ -2,6 +2,7 @@ package cmn
 
 import (
 	"bytes"
+	"context"
 	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
@@ -19,9 +20,12 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	modle "github.com/node-real/greenfield-bundle-service/models"
 	"github.com/node-real/greenfield-bundle-service/types"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
 	bundlesdk "github.com/bnb-chain/greenfield-bundle-sdk/bundle"
 	bundlesdktypes "github.com/bnb-chain/greenfield-bundle-sdk/types"
+
+	"github.com/bnb-chain/blob-hub/metrics"
 )
 
 const (
@@ -40,6 +44,8 @@ const (
 var (
 	ErrorBundleNotExist       = errors.New("the bundle not exist in bundle service")
 	ErrorBundleObjectNotExist = errors.New("the bundle object not exist in bundle service")
+	ErrorBundleExists         = errors.New("the bundle already exists in bundle service")
+	ErrorEmptyBundle          = errors.New("the bundle has no object")
 )
 
 type BundleClientOption interface {
@@ -75,7 +81,7 @@ func NewBundleClient(host string, opts ...BundleClientOption) (*BundleClient, er
 	}
 	client := &http.Client{
 		Timeout:   10 * time.Minute,
-		Transport: transport,
+		Transport: otelhttp.NewTransport(transport),
 	}
 	bundleClient := &BundleClient{hc: client,
 		host: host,
@@ -100,7 +106,7 @@ func (c *BundleClient) CreateBundle(bundleName, bucketName string) error {
 		"X-Bundle-Name":             bundleName,
 		"X-Bundle-Expiry-Timestamp": fmt.Sprintf("%d", time.Now().Add(1*time.Hour).Unix()),
 	}
-	resp, err := c.sendRequest(c.host+pathCreateBundle, "POST", headers, nil)
+	resp, err := c.sendRequest(pathCreateBundle, "POST", headers, nil)
 	if err != nil {
 		return err
 	}
@@ -110,7 +116,7 @@ func (c *BundleClient) CreateBundle(bundleName, bucketName string) error {
 		return err
 	}
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("received non-OK response status: %s, err %s", resp.Status, bodyStr)
+		return newResponseError(resp, bodyStr)
 	}
 	return nil
 }
@@ -123,7 +129,7 @@ func (c *BundleClient) FinalizeBundle(bundleName, bucketName string) error {
 		"X-Bundle-Expiry-Timestamp": fmt.Sprintf("%d", time.Now().Add(bundleExpiredTime).Unix()),
 	}
 	// finalize bundle
-	resp, err := c.sendRequest(c.host+pathFinalizeBundle, "POST", headers, nil)
+	resp, err := c.sendRequest(pathFinalizeBundle, "POST", headers, nil)
 	if err != nil {
 		return err
 	}
@@ -133,7 +139,7 @@ func (c *BundleClient) FinalizeBundle(bundleName, bucketName string) error {
 		return err
 	}
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("received non-OK response status: %s, err %s", resp.Status, bodyStr)
+		return newResponseError(resp, bodyStr)
 	}
 	return nil
 }
@@ -145,7 +151,7 @@ func (c *BundleClient) DeleteBundle(bundleName, bucketName string) error {
 		"X-Bundle-Name":             bundleName,
 		"X-Bundle-Expiry-Timestamp": fmt.Sprintf("%d", time.Now().Add(bundleExpiredTime).Unix()),
 	}
-	resp, err := c.sendRequest(c.host+pathDeleteBundle, "POST", headers, nil)
+	resp, err := c.sendRequest(pathDeleteBundle, "POST", headers, nil)
 	if err != nil {
 		return err
 	}
@@ -155,20 +161,14 @@ func (c *BundleClient) DeleteBundle(bundleName, bucketName string) error {
 		return err
 	}
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("received non-OK response status: %s, err %s", resp.Status, bodyStr)
+		return newResponseError(resp, bodyStr)
 	}
 	return nil
 }
 
 func (c *BundleClient) UploadAndFinalizeBundle(bundleName, bucketName, bundleDir, bundlePath string) error {
-
-	bundleObject, _, err := BundleObjectFromDirectory(bundleDir)
-	if err != nil {
-		return err
-	}
 	bundleFilePath := bundlePath
-	err = saveBundleToFile(bundleObject, bundleFilePath)
-	if err != nil {
+	if err := BuildBundleFile(bundleDir, bundleFilePath); err != nil {
 		return err
 	}
 
@@ -208,7 +208,8 @@ func (c *BundleClient) UploadAndFinalizeBundle(bundleName, bucketName, bundleDir
 		"X-Bundle-File-Sha256":      hashInHex,
 		"X-Bundle-Expiry-Timestamp": fmt.Sprintf("%d", time.Now().Add(bundleExpiredTime).Unix()),
 	}
-	resp, err := c.sendRequest(c.host+pathUploadBundle, "POST", headers, body.Bytes())
+	uploadStart := time.Now()
+	resp, err := c.sendRequest(pathUploadBundle, "POST", headers, body.Bytes())
 	if err != nil {
 		return err
 	}
@@ -218,8 +219,9 @@ func (c *BundleClient) UploadAndFinalizeBundle(bundleName, bucketName, bundleDir
 		return err
 	}
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("received non-OK response status: %s, err %s", resp.Status, bodyStr)
+		return newResponseError(resp, bodyStr)
 	}
+	metrics.ObserveStage(metrics.StageUpload, uploadStart)
 	return nil
 }
 
@@ -265,7 +267,7 @@ func (c *BundleClient) UploadObject(fileName, bucketName, bundleName, contentTyp
 		"X-Bundle-Expiry-Timestamp": fmt.Sprintf("%d", time.Now().Add(bundleExpiredTime).Unix()),
 		"X-Bundle-File-Sha256":      hashInHex,
 	}
-	resp, err := c.sendRequest(c.host+pathUploadObject, "POST", headers, body.Bytes())
+	resp, err := c.sendRequest(pathUploadObject, "POST", headers, body.Bytes())
 	if err != nil {
 		return err
 	}
@@ -275,7 +277,7 @@ func (c *BundleClient) UploadObject(fileName, bucketName, bundleName, contentTyp
 		return err
 	}
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("received non-OK response status: %s, err %s", resp.Status, bodyStr)
+		return newResponseError(resp, bodyStr)
 	}
 	return nil
 }
@@ -285,16 +287,16 @@ func (c *BundleClient) GetBundleInfo(bucketName, bundleName string) (*modle.Quer
 	if err != nil {
 		return nil, err
 	}
-	resp, err := c.hc.Do(req)
+	resp, err := doRequest(c.hc, req, c.host, "/v1/queryBundle")
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
 		if resp.StatusCode == http.StatusNotFound {
-			return nil, ErrorBundleNotExist
+			return nil, NewClassifiedError(ErrorClassNotFound, ErrorBundleNotExist)
 		}
-		return nil, fmt.Errorf("received non-OK response status: %s", resp.Status)
+		return nil, NewHTTPError(resp, "")
 	}
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -304,22 +306,22 @@ func (c *BundleClient) GetBundleInfo(bucketName, bundleName string) (*modle.Quer
 	return bundle, json.Unmarshal(body, bundle)
 }
 
-func (c *BundleClient) GetObject(bucketName, bundleName, objectName string) (string, error) {
+func (c *BundleClient) GetObject(ctx context.Context, bucketName, bundleName, objectName string) (string, error) {
 	path := fmt.Sprintf(pathGetBundleObject, bucketName, bundleName, objectName)
-	req, err := http.NewRequest("GET", c.host+path, nil)
+	req, err := http.NewRequestWithContext(ctx, "GET", c.host+path, nil)
 	if err != nil {
 		return "", err
 	}
-	resp, err := c.hc.Do(req)
+	resp, err := doRequest(c.hc, req, c.host, "/v1/view")
 	if err != nil {
 		return "", err
 	}
 	defer resp.Body.Close()
 	if resp.StatusCode != http.StatusOK {
 		if resp.StatusCode == http.StatusNotFound {
-			return "", ErrorBundleObjectNotExist
+			return "", NewClassifiedError(ErrorClassNotFound, ErrorBundleObjectNotExist)
 		}
-		return "", fmt.Errorf("received non-OK response status: %s", resp.Status)
+		return "", NewHTTPError(resp, "")
 	}
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
@@ -328,8 +330,8 @@ func (c *BundleClient) GetObject(bucketName, bundleName, objectName string) (str
 	return string(body), nil
 }
 
-func (c *BundleClient) sendRequest(url, method string, headers map[string]string, body []byte) (*http.Response, error) {
-	req, err := http.NewRequest(method, url, bytes.NewBuffer(body))
+func (c *BundleClient) sendRequest(path, method string, headers map[string]string, body []byte) (*http.Response, error) {
+	req, err := http.NewRequest(method, c.host+path, bytes.NewBuffer(body))
 	if err != nil {
 		return nil, err
 	}
@@ -341,7 +343,16 @@ func (c *BundleClient) sendRequest(url, method string, headers map[string]string
 		return nil, err
 	}
 	req.Header.Set(types.HTTPHeaderAuthorization, hex.EncodeToString(signature))
-	return c.hc.Do(req)
+	return doRequest(c.hc, req, c.host, path)
+}
+
+// newResponseError classifies the error response of bundle service
+func newResponseError(resp *http.Response, body string) error {
+	svcErr := &modle.Error{}
+	if json.Unmarshal([]byte(body), svcErr) == nil && svcErr.Code == types.ErrorObjectExist.Code {
+		return NewClassifiedError(ErrorClassPermanent, fmt.Errorf("%w: %s", ErrorBundleExists, body))
+	}
+	return NewHTTPError(resp, body)
 }
 
 func ReadResponseBody(resp *http.Response) (string, error) {
@@ -364,6 +375,20 @@ func (c *BundleClient) signMessage(message []byte) ([]byte, error) {
 	return signature, err
 }
 
+// BuildBundleFile assembles the files in the bundle dir into a bundle, and saves it to the bundle path
+func BuildBundleFile(bundleDir, bundlePath string) error {
+	buildStart := time.Now()
+	bundleObject, _, err := BundleObjectFromDirectory(bundleDir)
+	if err != nil {
+		return err
+	}
+	if err = saveBundleToFile(bundleObject, bundlePath); err != nil {
+		return err
+	}
+	metrics.ObserveStage(metrics.StageBundleBuild, buildStart)
+	return nil
+}
+
 func BundleObjectFromDirectory(dir string) (io.ReadSeekCloser, int64, error) {
 	b, err := bundlesdk.NewBundle()
 	if err != nil {
@@ -374,6 +399,9 @@ func BundleObjectFromDirectory(dir string) (io.ReadSeekCloser, int64, error) {
 	if err != nil {
 		return nil, 0, err
 	}
+	if len(b.GetBundleObjectsMeta()) == 0 {
+		return nil, 0, NewClassifiedError(ErrorClassPermanent, ErrorEmptyBundle)
+	}
 	return b.FinalizeBundle()
 }
 
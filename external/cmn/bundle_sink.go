@@ -0,0 +1,101 @@
+package cmn
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+
+	modle "github.com/node-real/greenfield-bundle-service/models"
+)
+
+// localBundleStatusFinalized matches the status of a finalized bundle in bundle service
+const localBundleStatusFinalized = 1
+
+// BundleSink is where the syncer puts the assembled bundles, it is the bundle service unless the syncer runs dry
+type BundleSink interface {
+	UploadAndFinalizeBundle(bundleName, bucketName, bundleDir, bundlePath string) error
+	GetBundleInfo(bucketName, bundleName string) (*modle.QueryBundleResponse, error)
+}
+
+var (
+	_ BundleSink = (*BundleClient)(nil)
+	_ BundleSink = (*NoopBundleSink)(nil)
+	_ BundleSink = (*LocalBundleSink)(nil)
+)
+
+// NoopBundleSink builds the bundles and discards them
+type NoopBundleSink struct{}
+
+func NewNoopBundleSink() *NoopBundleSink {
+	return &NoopBundleSink{}
+}
+
+func (s *NoopBundleSink) UploadAndFinalizeBundle(_, _, bundleDir, bundlePath string) error {
+	return BuildBundleFile(bundleDir, bundlePath)
+}
+
+func (s *NoopBundleSink) GetBundleInfo(_, _ string) (*modle.QueryBundleResponse, error) {
+	return nil, NewClassifiedError(ErrorClassNotFound, ErrorBundleNotExist)
+}
+
+// LocalBundleSink builds the bundles and keeps them at {dir}/{bucketName}/{bundleName}, so that they can be compared
+// with the bundles in the production bucket.
+type LocalBundleSink struct {
+	dir string
+}
+
+func NewLocalBundleSink(dir string) (*LocalBundleSink, error) {
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return nil, err
+	}
+	return &LocalBundleSink{dir: dir}, nil
+}
+
+func (s *LocalBundleSink) UploadAndFinalizeBundle(bundleName, bucketName, bundleDir, bundlePath string) error {
+	if err := BuildBundleFile(bundleDir, bundlePath); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Join(s.dir, bucketName), os.ModePerm); err != nil {
+		return err
+	}
+	src, err := os.Open(bundlePath)
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	// write to a temp file first, so that a bundle found in the dir is always complete
+	dstPath := s.bundlePath(bucketName, bundleName)
+	dst, err := os.Create(dstPath + ".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err = io.Copy(dst, src); err != nil {
+		dst.Close()
+		return err
+	}
+	if err = dst.Close(); err != nil {
+		return err
+	}
+	return os.Rename(dstPath+".tmp", dstPath)
+}
+
+func (s *LocalBundleSink) GetBundleInfo(bucketName, bundleName string) (*modle.QueryBundleResponse, error) {
+	info, err := os.Stat(s.bundlePath(bucketName, bundleName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, NewClassifiedError(ErrorClassNotFound, ErrorBundleNotExist)
+		}
+		return nil, err
+	}
+	return &modle.QueryBundleResponse{
+		BucketName:       bucketName,
+		BundleName:       bundleName,
+		CreatedTimestamp: info.ModTime().Unix(),
+		Size:             info.Size(),
+		Status:           localBundleStatusFinalized,
+	}, nil
+}
+
+func (s *LocalBundleSink) bundlePath(bucketName, bundleName string) string {
+	return filepath.Join(s.dir, bucketName, bundleName)
+}
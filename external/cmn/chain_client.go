@@ -7,6 +7,8 @@ import (
 	"io"
 	"net/http"
 	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 const (
@@ -28,7 +30,7 @@ func NewChainClient(host string) (*ChainClient, error) {
 	}
 	client := &http.Client{
 		Timeout:   10 * time.Minute,
-		Transport: transport,
+		Transport: otelhttp.NewTransport(transport),
 	}
 	return &ChainClient{hc: client, host: host}, nil
 }
@@ -38,14 +40,14 @@ func (c *ChainClient) GetObjectMeta(ctx context.Context, bucketName, objectName
 	if err != nil {
 		return nil, err
 	}
-	resp, err := c.hc.Do(req)
+	resp, err := doRequest(c.hc, req, c.host, "head_object")
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("received non-OK response status: %s", resp.Status)
+		return nil, NewHTTPError(resp, "")
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -66,14 +68,14 @@ func (c *ChainClient) GetParams(ctx context.Context) (*VersionedParams, error) {
 	if err != nil {
 		return nil, err
 	}
-	resp, err := c.hc.Do(req)
+	resp, err := doRequest(c.hc, req, c.host, "params")
 	if err != nil {
 		return nil, err
 	}
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("received non-OK response status: %s", resp.Status)
+		return nil, NewHTTPError(resp, "")
 	}
 
 	body, err := io.ReadAll(resp.Body)
@@ -0,0 +1,169 @@
+package cmn
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// ErrorClass tells how a failed call to an upstream endpoint should be handled
+type ErrorClass int
+
+const (
+	ErrorClassRetryable   ErrorClass = iota // a transient failure, e.g. a timeout or a 5xx response
+	ErrorClassPermanent                     // retrying the same call is not going to help, e.g. a 4xx response or a malformed body
+	ErrorClassNotFound                      // the requested resource does not exist (yet)
+	ErrorClassRateLimited                   // the endpoint asks to slow down, it might tell when to retry
+)
+
+func (c ErrorClass) String() string {
+	switch c {
+	case ErrorClassRetryable:
+		return "retryable"
+	case ErrorClassPermanent:
+		return "permanent"
+	case ErrorClassNotFound:
+		return "not_found"
+	case ErrorClassRateLimited:
+		return "rate_limited"
+	default:
+		return fmt.Sprintf("unknown(%d)", int(c))
+	}
+}
+
+// JSON-RPC error codes
+const (
+	rpcErrorCodeInvalidRequest = -32600
+	rpcErrorCodeMethodNotFound = -32601
+	rpcErrorCodeInvalidParams  = -32602
+	rpcErrorCodeLimitExceeded  = -32005 // returned by the RPC providers when the request rate is over the limit
+)
+
+// ClassifiedError is an error of an upstream call along with its class
+type ClassifiedError struct {
+	Class      ErrorClass
+	StatusCode int           // the HTTP status code, 0 if no response is received
+	RetryAfter time.Duration // the delay asked by the endpoint via Retry-After, 0 if not provided
+	Err        error
+}
+
+func (e *ClassifiedError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ClassifiedError) Unwrap() error {
+	return e.Err
+}
+
+// NewClassifiedError wraps the error with the class
+func NewClassifiedError(class ErrorClass, err error) error {
+	return &ClassifiedError{Class: class, Err: err}
+}
+
+// NewHTTPError returns the classified error of a non-OK response, the message is appended to the error if provided
+func NewHTTPError(resp *http.Response, message string) error {
+	err := fmt.Errorf("received non-OK response status: %s", resp.Status)
+	if message != "" {
+		err = fmt.Errorf("received non-OK response status: %s, err %s", resp.Status, message)
+	}
+	classified := &ClassifiedError{
+		Class:      classifyStatusCode(resp.StatusCode),
+		StatusCode: resp.StatusCode,
+		Err:        err,
+	}
+	if retryAfter := parseRetryAfter(resp.Header.Get("Retry-After")); retryAfter > 0 {
+		classified.Class = ErrorClassRateLimited
+		classified.RetryAfter = retryAfter
+	}
+	return classified
+}
+
+func classifyStatusCode(statusCode int) ErrorClass {
+	switch {
+	case statusCode == http.StatusNotFound:
+		return ErrorClassNotFound
+	case statusCode == http.StatusTooManyRequests:
+		return ErrorClassRateLimited
+	case statusCode == http.StatusRequestTimeout, statusCode >= http.StatusInternalServerError:
+		return ErrorClassRetryable
+	case statusCode >= http.StatusBadRequest:
+		return ErrorClassPermanent
+	default:
+		return ErrorClassRetryable
+	}
+}
+
+// parseRetryAfter parses the Retry-After header, which is either in seconds or an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds <= 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if delay := time.Until(date); delay > 0 {
+			return delay
+		}
+	}
+	return 0
+}
+
+// Classify returns the class of an error. Errors not classified by the clients are classified by their type, and
+// regarded as retryable if unknown.
+func Classify(err error) ErrorClass {
+	var classified *ClassifiedError
+	if errors.As(err, &classified) {
+		return classified.Class
+	}
+	if errors.Is(err, ethereum.NotFound) {
+		return ErrorClassNotFound
+	}
+	var httpErr rpc.HTTPError
+	if errors.As(err, &httpErr) {
+		return classifyStatusCode(httpErr.StatusCode)
+	}
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		switch rpcErr.ErrorCode() {
+		case rpcErrorCodeLimitExceeded:
+			return ErrorClassRateLimited
+		case rpcErrorCodeInvalidRequest, rpcErrorCodeMethodNotFound, rpcErrorCodeInvalidParams:
+			return ErrorClassPermanent
+		default:
+			return ErrorClassRetryable
+		}
+	}
+	var (
+		syntaxErr    *json.SyntaxError
+		unmarshalErr *json.UnmarshalTypeError
+	)
+	if errors.As(err, &syntaxErr) || errors.As(err, &unmarshalErr) {
+		return ErrorClassPermanent
+	}
+	// timeouts, connection failures and anything unknown
+	return ErrorClassRetryable
+}
+
+// RetryAfter returns the delay asked by the endpoint, 0 if not provided
+func RetryAfter(err error) time.Duration {
+	var classified *ClassifiedError
+	if errors.As(err, &classified) {
+		return classified.RetryAfter
+	}
+	return 0
+}
+
+// IsNotFound returns whether the error tells the requested resource does not exist
+func IsNotFound(err error) bool {
+	return err != nil && Classify(err) == ErrorClassNotFound
+}
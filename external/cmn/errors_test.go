@@ -0,0 +1,87 @@
+package cmn
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+type testRPCError struct {
+	code int
+}
+
+func (e *testRPCError) Error() string  { return fmt.Sprintf("rpc error %d", e.code) }
+func (e *testRPCError) ErrorCode() int { return e.code }
+
+func newResponse(statusCode int, retryAfter string) *http.Response {
+	resp := &http.Response{StatusCode: statusCode, Status: http.StatusText(statusCode), Header: http.Header{}}
+	if retryAfter != "" {
+		resp.Header.Set("Retry-After", retryAfter)
+	}
+	return resp
+}
+
+func TestClassify(t *testing.T) {
+	var syntaxErr error = &json.SyntaxError{}
+	tests := []struct {
+		name string
+		err  error
+		want ErrorClass
+	}{
+		{name: "unknown", err: errors.New("connection reset"), want: ErrorClassRetryable},
+		{name: "classified", err: NewClassifiedError(ErrorClassPermanent, errors.New("bad")), want: ErrorClassPermanent},
+		{name: "wrapped classified", err: fmt.Errorf("get blob: %w", NewClassifiedError(ErrorClassNotFound, errors.New("missing"))), want: ErrorClassNotFound},
+		{name: "http 404", err: NewHTTPError(newResponse(http.StatusNotFound, ""), ""), want: ErrorClassNotFound},
+		{name: "http 429", err: NewHTTPError(newResponse(http.StatusTooManyRequests, ""), ""), want: ErrorClassRateLimited},
+		{name: "http 503 with retry-after", err: NewHTTPError(newResponse(http.StatusServiceUnavailable, "3"), ""), want: ErrorClassRateLimited},
+		{name: "http 408", err: NewHTTPError(newResponse(http.StatusRequestTimeout, ""), ""), want: ErrorClassRetryable},
+		{name: "http 500", err: NewHTTPError(newResponse(http.StatusInternalServerError, ""), ""), want: ErrorClassRetryable},
+		{name: "http 400", err: NewHTTPError(newResponse(http.StatusBadRequest, ""), "bad request"), want: ErrorClassPermanent},
+		{name: "ethereum not found", err: fmt.Errorf("header: %w", ethereum.NotFound), want: ErrorClassNotFound},
+		{name: "rpc http 502", err: rpc.HTTPError{StatusCode: http.StatusBadGateway}, want: ErrorClassRetryable},
+		{name: "rpc http 403", err: rpc.HTTPError{StatusCode: http.StatusForbidden}, want: ErrorClassPermanent},
+		{name: "rpc limit exceeded", err: &testRPCError{code: rpcErrorCodeLimitExceeded}, want: ErrorClassRateLimited},
+		{name: "rpc method not found", err: &testRPCError{code: rpcErrorCodeMethodNotFound}, want: ErrorClassPermanent},
+		{name: "rpc invalid params", err: &testRPCError{code: rpcErrorCodeInvalidParams}, want: ErrorClassPermanent},
+		{name: "rpc internal", err: &testRPCError{code: -32603}, want: ErrorClassRetryable},
+		{name: "json syntax", err: fmt.Errorf("decode: %w", syntaxErr), want: ErrorClassPermanent},
+		{name: "json type", err: &json.UnmarshalTypeError{Value: "string"}, want: ErrorClassPermanent},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Classify(tt.err); got != tt.want {
+				t.Errorf("Classify() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		min   time.Duration
+		max   time.Duration
+	}{
+		{name: "empty", value: ""},
+		{name: "seconds", value: "30", min: 30 * time.Second, max: 30 * time.Second},
+		{name: "zero", value: "0"},
+		{name: "negative", value: "-5"},
+		{name: "garbage", value: "soon"},
+		{name: "future date", value: time.Now().Add(time.Minute).UTC().Format(http.TimeFormat), min: 55 * time.Second, max: time.Minute},
+		{name: "past date", value: time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := parseRetryAfter(tt.value); got < tt.min || got > tt.max {
+				t.Errorf("parseRetryAfter(%q) = %s, want within [%s, %s]", tt.value, got, tt.min, tt.max)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package cmn
+
+import (
+	"net/http"
+
+	"github.com/bnb-chain/blob-hub/metrics"
+)
+
+// doRequest sends the request and counts the failed calls to the endpoint by method. A not found response is a valid
+// answer and not counted.
+func doRequest(hc *http.Client, req *http.Request, endpoint, method string) (*http.Response, error) {
+	resp, err := hc.Do(req)
+	if err != nil {
+		metrics.ObserveRPCError(endpoint, method)
+		return nil, err
+	}
+	if (resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices) && resp.StatusCode != http.StatusNotFound {
+		metrics.ObserveRPCError(endpoint, method)
+	}
+	return resp, nil
+}
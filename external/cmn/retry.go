@@ -0,0 +1,95 @@
+package cmn
+
+import (
+	"context"
+	"math/rand"
+	"time"
+)
+
+// RetryPolicy paces the retries of failed upstream calls with jittered exponential backoff. The class of the error
+// decides the delay:
+//  1. retryable and not found errors back off exponentially from BaseDelay up to MaxDelay
+//  2. rate limited errors wait for the Retry-After of the endpoint if it is longer than the backoff
+//  3. permanent errors wait for MaxDelay, as retrying sooner is not going to help
+type RetryPolicy struct {
+	BaseDelay   time.Duration
+	MaxDelay    time.Duration
+	Multiplier  float64
+	Jitter      float64 // Jitter is the fraction of the delay to randomize by, in [0, 1)
+	MaxAttempts int     // MaxAttempts is the number of calls made by Do, 0 for unlimited
+}
+
+var DefaultRetryPolicy = RetryPolicy{
+	BaseDelay:   1 * time.Second,
+	MaxDelay:    1 * time.Minute,
+	Multiplier:  2,
+	Jitter:      0.2,
+	MaxAttempts: 5,
+}
+
+// Backoff keeps the number of consecutive failures to compute the next delay
+type Backoff struct {
+	policy   RetryPolicy
+	failures int
+}
+
+func (p RetryPolicy) NewBackoff() *Backoff {
+	return &Backoff{policy: p}
+}
+
+// Next records a failure and returns the delay before the next attempt
+func (b *Backoff) Next(err error) time.Duration {
+	b.failures++
+	p := b.policy
+	if Classify(err) == ErrorClassPermanent {
+		return p.MaxDelay
+	}
+	delay := float64(p.BaseDelay)
+	for i := 1; i < b.failures && delay < float64(p.MaxDelay); i++ {
+		delay *= p.Multiplier
+	}
+	if p.Jitter > 0 {
+		delay += delay * p.Jitter * (2*rand.Float64() - 1)
+	}
+	if delay > float64(p.MaxDelay) {
+		delay = float64(p.MaxDelay)
+	}
+	// the endpoint knows better when it is ready again, it is not capped by MaxDelay
+	if retryAfter := RetryAfter(err); retryAfter > time.Duration(delay) {
+		return retryAfter
+	}
+	return time.Duration(delay)
+}
+
+// Reset clears the failures after a successful attempt
+func (b *Backoff) Reset() {
+	b.failures = 0
+}
+
+// Failures returns the number of consecutive failures
+func (b *Backoff) Failures() int {
+	return b.failures
+}
+
+// Do calls fn until it succeeds, fails with a permanent error, the max attempts is reached or the context is done.
+// The last error is returned.
+func (p RetryPolicy) Do(ctx context.Context, fn func() error) error {
+	backoff := p.NewBackoff()
+	for {
+		err := fn()
+		if err == nil || Classify(err) == ErrorClassPermanent {
+			return err
+		}
+		delay := backoff.Next(err)
+		if p.MaxAttempts > 0 && backoff.Failures() >= p.MaxAttempts {
+			return err
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package cmn
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBackoffNext(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Second, MaxDelay: 10 * time.Second, Multiplier: 2}
+	retryable := errors.New("timeout")
+	tests := []struct {
+		name string
+		errs []error
+		want []time.Duration
+	}{
+		{
+			name: "exponential up to max delay",
+			errs: []error{retryable, retryable, retryable, retryable, retryable},
+			want: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second},
+		},
+		{
+			name: "permanent waits for max delay",
+			errs: []error{NewClassifiedError(ErrorClassPermanent, errors.New("bad request"))},
+			want: []time.Duration{10 * time.Second},
+		},
+		{
+			name: "retry-after longer than the backoff",
+			errs: []error{NewHTTPError(newResponse(http.StatusTooManyRequests, "30"), "")},
+			want: []time.Duration{30 * time.Second},
+		},
+		{
+			name: "retry-after shorter than the backoff",
+			errs: []error{retryable, retryable, retryable, NewHTTPError(newResponse(http.StatusTooManyRequests, "2"), "")},
+			want: []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backoff := policy.NewBackoff()
+			for i, err := range tt.errs {
+				if got := backoff.Next(err); got != tt.want[i] {
+					t.Errorf("delay %d = %s, want %s", i, got, tt.want[i])
+				}
+			}
+			if backoff.Failures() != len(tt.errs) {
+				t.Errorf("failures = %d, want %d", backoff.Failures(), len(tt.errs))
+			}
+			backoff.Reset()
+			if got := backoff.Next(retryable); got != policy.BaseDelay {
+				t.Errorf("delay after reset = %s, want %s", got, policy.BaseDelay)
+			}
+		})
+	}
+}
+
+func TestBackoffJitter(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Second, MaxDelay: time.Minute, Multiplier: 2, Jitter: 0.2}
+	for i := 0; i < 100; i++ {
+		backoff := policy.NewBackoff()
+		backoff.Next(errors.New("timeout"))
+		if got := backoff.Next(errors.New("timeout")); got < 1600*time.Millisecond || got > 2400*time.Millisecond {
+			t.Fatalf("delay = %s, want within 2s±20%%", got)
+		}
+	}
+}
+
+func TestRetryPolicyDo(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Millisecond, MaxDelay: time.Millisecond, Multiplier: 2, MaxAttempts: 3}
+	permanent := NewClassifiedError(ErrorClassPermanent, errors.New("bad request"))
+	retryable := errors.New("timeout")
+	tests := []struct {
+		name      string
+		errs      []error
+		wantCalls int
+		wantErr   error
+	}{
+		{name: "success", errs: []error{nil}, wantCalls: 1},
+		{name: "success after retries", errs: []error{retryable, retryable, nil}, wantCalls: 3},
+		{name: "permanent error", errs: []error{permanent}, wantCalls: 1, wantErr: permanent},
+		{name: "max attempts", errs: []error{retryable, retryable, retryable, nil}, wantCalls: 3, wantErr: retryable},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			err := policy.Do(context.Background(), func() error {
+				calls++
+				return tt.errs[calls-1]
+			})
+			if !errors.Is(err, tt.wantErr) || (tt.wantErr == nil && err != nil) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+			if calls != tt.wantCalls {
+				t.Errorf("calls = %d, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestRetryPolicyDoContextDone(t *testing.T) {
+	policy := RetryPolicy{BaseDelay: time.Hour, MaxDelay: time.Hour, Multiplier: 2}
+	ctx, cancel := context.WithCancel(context.Background())
+	calls := 0
+	err := policy.Do(ctx, func() error {
+		calls++
+		cancel()
+		return errors.New("timeout")
+	})
+	if err == nil || calls != 1 {
+		t.Fatalf("err = %v, calls = %d, want the error of the single call", err, calls)
+	}
+}
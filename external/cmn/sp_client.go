@@ -8,6 +8,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 type SPClient struct {
@@ -24,7 +26,7 @@ func NewSPClient(host string) (*SPClient, error) {
 	}
 	client := &http.Client{
 		Timeout:   10 * time.Minute,
-		Transport: transport,
+		Transport: otelhttp.NewTransport(transport),
 	}
 	return &SPClient{hc: client, host: host}, nil
 }
@@ -50,11 +52,14 @@ func (c *SPClient) GetBucketReadQuota(ctx context.Context, bucketName string) (Q
 	q.Add("read-quota", "")
 	q.Add("year-month", date)
 	req.URL.RawQuery = q.Encode()
-	resp, err := c.hc.Do(req)
+	resp, err := doRequest(c.hc, req, c.host, "read_quota")
 	if err != nil {
 		return QuotaInfo{}, err
 	}
 	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return QuotaInfo{}, NewHTTPError(resp, "")
+	}
 	QuotaResult := QuotaInfo{}
 	err = xml.NewDecoder(resp.Body).Decode(&QuotaResult)
 	if err != nil {
@@ -72,9 +77,13 @@ func (c *SPClient) GetBundleObject(ctx context.Context, bucketName, objectName s
 	if err != nil {
 		return nil, err
 	}
-	resp, err := c.hc.Do(req)
+	resp, err := doRequest(c.hc, req, c.host, "get_object")
 	if err != nil {
 		return nil, err
 	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, NewHTTPError(resp, "")
+	}
 	return resp.Body, nil
 }
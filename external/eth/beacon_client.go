@@ -11,6 +11,9 @@ import (
 	"time"
 
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+
+	"github.com/bnb-chain/blob-hub/external/cmn"
 )
 
 var (
@@ -38,7 +41,7 @@ func NewBeaconClient(host string) (*BeaconClient, error) {
 	}
 	client := &http.Client{
 		Timeout:   10 * time.Minute,
-		Transport: transport,
+		Transport: otelhttp.NewTransport(transport),
 	}
 	return &BeaconClient{hc: client, host: host}, nil
 }
@@ -59,6 +62,13 @@ func (c *BeaconClient) GetBlob(ctx context.Context, slotNumber uint64) ([]*struc
 	if err != nil {
 		return nil, fmt.Errorf("error reading http response body %s", err)
 	}
+	if r.StatusCode != http.StatusOK {
+		// a skipped or forked slot has no blob
+		if r.StatusCode == http.StatusNotFound {
+			return nil, nil
+		}
+		return nil, cmn.NewHTTPError(r, string(respBz))
+	}
 	var sidecars structs.SidecarsResponse
 	err = json.Unmarshal(respBz, &sidecars)
 	if err != nil {
@@ -86,9 +96,9 @@ func (c *BeaconClient) GetBeaconBlock(ctx context.Context, slotNumber uint64) (*
 
 	if r.StatusCode != http.StatusOK {
 		if r.StatusCode == http.StatusNotFound {
-			return nil, ErrBlockNotFound
+			return nil, cmn.NewClassifiedError(cmn.ErrorClassNotFound, ErrBlockNotFound)
 		}
-		return nil, fmt.Errorf("received non-OK response status: %s", r.Status)
+		return nil, cmn.NewHTTPError(r, "")
 	}
 	resp := &structs.GetBlockV2Response{}
 	return resp, json.Unmarshal(b, resp)
@@ -111,6 +121,9 @@ func (c *BeaconClient) GetLatestBeaconBlock(ctx context.Context) (*structs.GetBl
 	if err != nil {
 		return nil, fmt.Errorf("error reading http response body %s", err)
 	}
+	if r.StatusCode != http.StatusOK {
+		return nil, cmn.NewHTTPError(r, "")
+	}
 	resp := &structs.GetBlockV2Response{}
 	return resp, json.Unmarshal(b, resp)
 
@@ -135,9 +148,9 @@ func (c *BeaconClient) GetBeaconHeader(ctx context.Context, slotNumber uint64) (
 
 	if r.StatusCode != http.StatusOK {
 		if r.StatusCode == http.StatusNotFound {
-			return nil, ErrBlockNotFound
+			return nil, cmn.NewClassifiedError(cmn.ErrorClassNotFound, ErrBlockNotFound)
 		}
-		return nil, fmt.Errorf("received non-OK response status: %s", r.Status)
+		return nil, cmn.NewHTTPError(r, "")
 	}
 	resp := &structs.GetBlockHeaderResponse{}
 	return resp, json.Unmarshal(b, resp)
@@ -2,6 +2,8 @@ package metrics
 
 import (
 	"net/http"
+	"net/url"
+	"time"
 
 	"github.com/gorilla/mux"
 	"github.com/prometheus/client_golang/prometheus"
@@ -21,16 +23,171 @@ var (
 		Help: "Verified slot number, all blobs have been verified against the bundle service.",
 	})
 
+	SyncLagGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "sync_lag",
+		Help: "Number of slots(ETH) or blocks(BSC) the synced block lags the chain head.",
+	})
+
 	BucketRemainingQuotaGauge = prometheus.NewGauge(prometheus.GaugeOpts{
 		Name: "bucket_remaining_quota",
 		Help: "Remaining read quota of bucket in bytes",
 	})
 
+	BucketQuotaConsumptionRateGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "bucket_quota_consumption_rate",
+		Help: "Read quota consumption rate of bucket in bytes per second over the forecast window",
+	})
+
+	BucketQuotaExhaustionTimeGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "bucket_quota_exhaustion_time",
+		Help: "Forecast unix time when the read quota of bucket runs out, 0 if it lasts until the monthly reset",
+	})
+
+	BucketQuotaAlertLevelGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "bucket_quota_alert_level",
+		Help: "Read quota alert level of bucket, 0 for normal, 1 for warning and 2 for critical",
+	})
+
+	TempDirFreeSpaceGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "temp_dir_free_space",
+		Help: "Free disk space of the syncer temp dir in bytes",
+	})
+
+	StageDurationHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "syncer_stage_duration_seconds",
+		Help:    "Latency of the syncer stages in seconds.",
+		Buckets: prometheus.ExponentialBuckets(0.01, 2, 15),
+	}, []string{"stage"})
+
+	RPCErrorCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "upstream_rpc_errors_total",
+		Help: "Number of failed calls to upstream endpoints.",
+	}, []string{"endpoint", "method"})
+
+	ReUploadCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "bundle_reuploads_total",
+		Help: "Number of re-uploaded bundles.",
+	}, []string{"reason"})
+
+	ArchivedBlobsCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "archived_blobs_total",
+		Help: "Number of blobs archived by the syncer.",
+	})
+
+	ArchivedBytesCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "archived_blob_bytes_total",
+		Help: "Size of blobs archived by the syncer in bytes.",
+	})
+
+	ScannedBlockIDGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "scanned_block_id",
+		Help: "The last slot(ETH) or block(BSC) checked by the background scanner.",
+	})
+
+	ScanProblemCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "scan_problems_total",
+		Help: "Number of problems found by the scanner in the block table.",
+	}, []string{"kind"})
+
+	RepairCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "repairs_total",
+		Help: "Number of repair attempts by the syncer.",
+	}, []string{"kind", "result"})
+
 	MetricsItems = []prometheus.Collector{
 		SyncedBlockIDGauge,
 		VerifiedBlockIDGauge,
+		SyncLagGauge,
 		BucketRemainingQuotaGauge,
+		BucketQuotaConsumptionRateGauge,
+		BucketQuotaExhaustionTimeGauge,
+		BucketQuotaAlertLevelGauge,
+		TempDirFreeSpaceGauge,
+		StageDurationHistogram,
+		RPCErrorCounter,
+		ReUploadCounter,
+		ArchivedBlobsCounter,
+		ArchivedBytesCounter,
+		ScannedBlockIDGauge,
+		ScanProblemCounter,
+		RepairCounter,
 	}
+
+	RequestDurationHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "server_request_duration_seconds",
+		Help:    "Latency of the API requests in seconds.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route", "method", "code"})
+
+	CacheRequestCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "server_cache_requests_total",
+		Help: "Number of cache lookups by result, hit or miss.",
+	}, []string{"result"})
+
+	CacheSizeBytesGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "server_cache_size_bytes",
+		Help: "Total size of the blobs in the local cache in bytes.",
+	})
+
+	CacheEvictionCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "server_cache_evictions_total",
+		Help: "Number of blobs evicted from the local cache to make room.",
+	})
+
+	CacheAdmissionRejectCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "server_cache_admission_rejections_total",
+		Help: "Number of blobs not admitted to the full local cache, as they were not missed before or are too large.",
+	})
+
+	RedisCacheErrorCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "server_redis_cache_errors_total",
+		Help: "Number of failed Redis cache calls, the local cache serves while Redis is unavailable.",
+	})
+
+	BundleFetchDurationHistogram = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Name:    "server_bundle_fetch_duration_seconds",
+		Help:    "Latency of fetching a blob from bundle service in seconds.",
+		Buckets: prometheus.DefBuckets,
+	})
+
+	SubscribersGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "server_block_event_subscribers",
+		Help: "Number of the subscribers to the block events by transport.",
+	}, []string{"transport"})
+
+	BlockEventIDGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "server_block_event_id",
+		Help: "Id of the latest block event read from DB.",
+	})
+
+	ServerMetricsItems = []prometheus.Collector{
+		RequestDurationHistogram,
+		CacheRequestCounter,
+		CacheSizeBytesGauge,
+		CacheEvictionCounter,
+		CacheAdmissionRejectCounter,
+		RedisCacheErrorCounter,
+		BundleFetchDurationHistogram,
+		RPCErrorCounter,
+		SubscribersGauge,
+		BlockEventIDGauge,
+	}
+)
+
+const (
+	StageBlockFetch   = "block_fetch"
+	StageSidecarFetch = "sidecar_fetch"
+	StageDBSave       = "db_save"
+	StageBundleBuild  = "bundle_build"
+	StageUpload       = "upload"
+	StageVerify       = "verify"
+
+	CacheHit  = "hit"
+	CacheMiss = "miss"
+
+	TransportGRPC      = "grpc"
+	TransportSSE       = "sse"
+	TransportWebsocket = "websocket"
 )
 
 const DefaultMetricsAddress = "0.0.0.0:9090"
@@ -39,17 +196,29 @@ type Metrics struct {
 	httpAddress string
 	registry    *prometheus.Registry
 	httpServer  *http.Server
+	items       []prometheus.Collector
 }
 
+// NewMetrics returns the metrics of syncer
 func NewMetrics(address string) *Metrics {
 	return &Metrics{
 		httpAddress: address,
 		registry:    prometheus.NewRegistry(),
+		items:       MetricsItems,
+	}
+}
+
+// NewServerMetrics returns the metrics of server
+func NewServerMetrics(address string) *Metrics {
+	return &Metrics{
+		httpAddress: address,
+		registry:    prometheus.NewRegistry(),
+		items:       ServerMetricsItems,
 	}
 }
 
 func (m *Metrics) Start() {
-	m.registry.MustRegister(MetricsItems...)
+	m.registry.MustRegister(m.items...)
 	go m.serve()
 }
 
@@ -65,3 +234,17 @@ func (m *Metrics) serve() {
 		panic(err)
 	}
 }
+
+// ObserveStage records the latency of a syncer stage started at the given time
+func ObserveStage(stage string, start time.Time) {
+	StageDurationHistogram.WithLabelValues(stage).Observe(time.Since(start).Seconds())
+}
+
+// ObserveRPCError counts a failed call to an upstream endpoint. Only the host of the endpoint is used as the label, as
+// the path of an endpoint might contain an API key.
+func ObserveRPCError(endpoint, method string) {
+	if u, err := url.Parse(endpoint); err == nil && u.Host != "" {
+		endpoint = u.Host
+	}
+	RPCErrorCounter.WithLabelValues(endpoint, method).Inc()
+}
@@ -0,0 +1,73 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// BlobMeta blob meta
+//
+// swagger:model BlobMeta
+type BlobMeta struct {
+
+	// sender of the blob tx, empty for the blobs archived before it was recorded
+	From string `json:"from,omitempty"`
+
+	// index
+	// Example: 0
+	Index string `json:"index,omitempty"`
+
+	// kzg commitment
+	KzgCommitment string `json:"kzg_commitment,omitempty"`
+
+	// slot(ETH) or block number(BSC) of the blob
+	// Example: 8783262
+	Slot string `json:"slot,omitempty"`
+
+	// to
+	To string `json:"to,omitempty"`
+
+	// tx hash
+	TxHash string `json:"tx_hash,omitempty"`
+
+	// tx index
+	TxIndex int64 `json:"tx_index"`
+
+	// versioned hash
+	VersionedHash string `json:"versioned_hash,omitempty"`
+}
+
+// Validate validates this blob meta
+func (m *BlobMeta) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this blob meta based on context it is used
+func (m *BlobMeta) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *BlobMeta) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *BlobMeta) UnmarshalBinary(b []byte) error {
+	var res BlobMeta
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
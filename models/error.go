@@ -7,9 +7,12 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
+	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // Error error
@@ -24,10 +27,90 @@ type Error struct {
 	// Error message
 	// Example: Bad request/Internal server error
 	Message string `json:"message"`
+
+	// Machine-readable reason of the error
+	// Example: BLOCK_NOT_FOUND
+	// Enum: [INVALID_ARGUMENT NOT_ACCEPTABLE NOT_SUPPORTED BLOCK_NOT_FOUND NOT_ARCHIVED_YET BLOB_NOT_FOUND BUNDLE_NOT_FOUND RATE_LIMITED UNAVAILABLE INTERNAL]
+	Reason string `json:"reason,omitempty"`
 }
 
 // Validate validates this error
 func (m *Error) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateReason(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var errorTypeReasonPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["INVALID_ARGUMENT","NOT_ACCEPTABLE","NOT_SUPPORTED","BLOCK_NOT_FOUND","NOT_ARCHIVED_YET","BLOB_NOT_FOUND","BUNDLE_NOT_FOUND","RATE_LIMITED","UNAVAILABLE","INTERNAL"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		errorTypeReasonPropEnum = append(errorTypeReasonPropEnum, v)
+	}
+}
+
+const (
+
+	// ErrorReasonINVALIDARGUMENT captures enum value "INVALID_ARGUMENT"
+	ErrorReasonINVALIDARGUMENT string = "INVALID_ARGUMENT"
+
+	// ErrorReasonNOTACCEPTABLE captures enum value "NOT_ACCEPTABLE"
+	ErrorReasonNOTACCEPTABLE string = "NOT_ACCEPTABLE"
+
+	// ErrorReasonNOTSUPPORTED captures enum value "NOT_SUPPORTED"
+	ErrorReasonNOTSUPPORTED string = "NOT_SUPPORTED"
+
+	// ErrorReasonBLOCKNOTFOUND captures enum value "BLOCK_NOT_FOUND"
+	ErrorReasonBLOCKNOTFOUND string = "BLOCK_NOT_FOUND"
+
+	// ErrorReasonNOTARCHIVEDYET captures enum value "NOT_ARCHIVED_YET"
+	ErrorReasonNOTARCHIVEDYET string = "NOT_ARCHIVED_YET"
+
+	// ErrorReasonBLOBNOTFOUND captures enum value "BLOB_NOT_FOUND"
+	ErrorReasonBLOBNOTFOUND string = "BLOB_NOT_FOUND"
+
+	// ErrorReasonBUNDLENOTFOUND captures enum value "BUNDLE_NOT_FOUND"
+	ErrorReasonBUNDLENOTFOUND string = "BUNDLE_NOT_FOUND"
+
+	// ErrorReasonRATELIMITED captures enum value "RATE_LIMITED"
+	ErrorReasonRATELIMITED string = "RATE_LIMITED"
+
+	// ErrorReasonUNAVAILABLE captures enum value "UNAVAILABLE"
+	ErrorReasonUNAVAILABLE string = "UNAVAILABLE"
+
+	// ErrorReasonINTERNAL captures enum value "INTERNAL"
+	ErrorReasonINTERNAL string = "INTERNAL"
+)
+
+// prop value enum
+func (m *Error) validateReasonEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, errorTypeReasonPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *Error) validateReason(formats strfmt.Registry) error {
+	if swag.IsZero(m.Reason) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateReasonEnum("reason", "body", m.Reason); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -19,6 +19,9 @@ import (
 // swagger:model GetBlobSideCarsResponse
 type GetBlobSideCarsResponse struct {
 
+	// number of slots the archive lags the chain head by, only set when the block is identified by 'head', 'finalized' or 'genesis' and the network is configured
+	ArchiveLag *int64 `json:"archive_lag,omitempty"`
+
 	// status code
 	// Example: 200
 	Code int64 `json:"code,omitempty"`
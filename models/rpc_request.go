@@ -17,9 +17,9 @@ import (
 // swagger:model RPCRequest
 type RPCRequest struct {
 
-	// id
+	// number or string identifier of the request
 	// Example: 1
-	ID int64 `json:"id,omitempty"`
+	ID interface{} `json:"id,omitempty"`
 
 	// jsonrpc
 	// Example: 2.0
@@ -31,7 +31,7 @@ type RPCRequest struct {
 
 	// params
 	// Example: ["0x1",true]
-	Params []string `json:"params"`
+	Params []interface{} `json:"params"`
 }
 
 // Validate validates this RPC request
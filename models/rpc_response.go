@@ -7,7 +7,6 @@ package models
 
 import (
 	"context"
-	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -22,16 +21,16 @@ type RPCResponse struct {
 	// error
 	Error *RPCError `json:"error,omitempty"`
 
-	// id
+	// identifier of the request
 	// Example: 1
-	ID int64 `json:"id,omitempty"`
+	ID interface{} `json:"id"`
 
 	// jsonrpc
 	// Example: 2.0
 	Jsonrpc string `json:"jsonrpc,omitempty"`
 
-	// result
-	Result []*BSCBlobTxSidecar `json:"result"`
+	// array of BSCBlobTxSidecar for eth_getBlobSidecars, a BSCBlobTxSidecar for eth_getBlobSidecarByTxHash, or null if not found
+	Result interface{} `json:"result"`
 }
 
 // Validate validates this RPC response
@@ -42,10 +41,6 @@ func (m *RPCResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
-	if err := m.validateResult(formats); err != nil {
-		res = append(res, err)
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -71,32 +66,6 @@ func (m *RPCResponse) validateError(formats strfmt.Registry) error {
 	return nil
 }
 
-func (m *RPCResponse) validateResult(formats strfmt.Registry) error {
-	if swag.IsZero(m.Result) { // not required
-		return nil
-	}
-
-	for i := 0; i < len(m.Result); i++ {
-		if swag.IsZero(m.Result[i]) { // not required
-			continue
-		}
-
-		if m.Result[i] != nil {
-			if err := m.Result[i].Validate(formats); err != nil {
-				if ve, ok := err.(*errors.Validation); ok {
-					return ve.ValidateName("result" + "." + strconv.Itoa(i))
-				} else if ce, ok := err.(*errors.CompositeError); ok {
-					return ce.ValidateName("result" + "." + strconv.Itoa(i))
-				}
-				return err
-			}
-		}
-
-	}
-
-	return nil
-}
-
 // ContextValidate validate this RPC response based on the context it is used
 func (m *RPCResponse) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
@@ -105,10 +74,6 @@ func (m *RPCResponse) ContextValidate(ctx context.Context, formats strfmt.Regist
 		res = append(res, err)
 	}
 
-	if err := m.contextValidateResult(ctx, formats); err != nil {
-		res = append(res, err)
-	}
-
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -131,26 +96,6 @@ func (m *RPCResponse) contextValidateError(ctx context.Context, formats strfmt.R
 	return nil
 }
 
-func (m *RPCResponse) contextValidateResult(ctx context.Context, formats strfmt.Registry) error {
-
-	for i := 0; i < len(m.Result); i++ {
-
-		if m.Result[i] != nil {
-			if err := m.Result[i].ContextValidate(ctx, formats); err != nil {
-				if ve, ok := err.(*errors.Validation); ok {
-					return ve.ValidateName("result" + "." + strconv.Itoa(i))
-				} else if ce, ok := err.(*errors.CompositeError); ok {
-					return ce.ValidateName("result" + "." + strconv.Itoa(i))
-				}
-				return err
-			}
-		}
-
-	}
-
-	return nil
-}
-
 // MarshalBinary interface implementation
 func (m *RPCResponse) MarshalBinary() ([]byte, error) {
 	if m == nil {
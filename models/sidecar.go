@@ -42,6 +42,9 @@ type Sidecar struct {
 
 	// tx index
 	TxIndex int64 `json:"tx_index,omitempty"`
+
+	// the blob is not archived yet, it is served from the chain after its KZG proof is verified
+	Unarchived bool `json:"unarchived,omitempty"`
 }
 
 // Validate validates this sidecar
@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// VersionedBlob versioned blob
+//
+// swagger:model VersionedBlob
+type VersionedBlob struct {
+
+	// sidecar
+	Sidecar *Sidecar `json:"sidecar,omitempty"`
+
+	// slot(ETH) or block number(BSC) of the blob
+	// Example: 8783262
+	Slot string `json:"slot,omitempty"`
+
+	// tx hash
+	TxHash string `json:"tx_hash,omitempty"`
+
+	// versioned hash
+	VersionedHash string `json:"versioned_hash,omitempty"`
+}
+
+// Validate validates this versioned blob
+func (m *VersionedBlob) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSidecar(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *VersionedBlob) validateSidecar(formats strfmt.Registry) error {
+	if swag.IsZero(m.Sidecar) { // not required
+		return nil
+	}
+
+	if m.Sidecar != nil {
+		if err := m.Sidecar.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sidecar")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("sidecar")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this versioned blob based on the context it is used
+func (m *VersionedBlob) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSidecar(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *VersionedBlob) contextValidateSidecar(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Sidecar != nil {
+		if err := m.Sidecar.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("sidecar")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("sidecar")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *VersionedBlob) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *VersionedBlob) UnmarshalBinary(b []byte) error {
+	var res VersionedBlob
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
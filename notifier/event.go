@@ -0,0 +1,54 @@
+package notifier
+
+type EventType string
+
+const (
+	EventBundleFinalized    EventType = "bundle_finalized"
+	EventBundleSealed       EventType = "bundle_sealed"
+	EventVerificationFailed EventType = "verification_failed"
+	EventBundleCalibrated   EventType = "bundle_calibrated"
+	EventSyncLag            EventType = "sync_lag"
+	EventQuotaLow           EventType = "quota_low"
+)
+
+// Event is the JSON payload posted to webhook endpoints
+type Event struct {
+	Type       EventType   `json:"type"`
+	Time       int64       `json:"time"`
+	Chain      string      `json:"chain"`
+	BucketName string      `json:"bucket_name"`
+	Data       interface{} `json:"data"`
+}
+
+// BundleEvent is the data of bundle finalized, sealed and calibrated events
+type BundleEvent struct {
+	BundleName           string `json:"bundle_name"`
+	StartBlockID         uint64 `json:"start_block_id"`
+	EndBlockID           uint64 `json:"end_block_id"`
+	Calibrated           bool   `json:"calibrated"`
+	DeprecatedBundleName string `json:"deprecated_bundle_name,omitempty"` // the bundle replaced by a calibrated bundle
+}
+
+// VerificationFailedEvent is the data of verification failed events, the bundle is re-uploaded afterwards
+type VerificationFailedEvent struct {
+	BundleName string `json:"bundle_name"`
+	BlockID    uint64 `json:"block_id,omitempty"`
+	Reason     string `json:"reason"`
+}
+
+// SyncLagEvent is the data of sync lag events
+type SyncLagEvent struct {
+	HeadBlockID   uint64 `json:"head_block_id"`
+	SyncedBlockID uint64 `json:"synced_block_id"`
+	Lag           uint64 `json:"lag"`
+	Threshold     uint64 `json:"threshold"`
+}
+
+// QuotaEvent is the data of quota low events
+type QuotaEvent struct {
+	Level          string  `json:"level"`
+	Remaining      uint64  `json:"remaining"`
+	Total          uint64  `json:"total"`
+	Rate           float64 `json:"rate"`                      // consumption rate in bytes per second
+	ExhaustionTime int64   `json:"exhaustion_time,omitempty"` // forecast unix time when the quota runs out
+}
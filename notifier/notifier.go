@@ -0,0 +1,198 @@
+package notifier
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/bnb-chain/blob-hub/config"
+	"github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/logging"
+	"github.com/bnb-chain/blob-hub/util"
+)
+
+const (
+	HeaderEvent     = "X-Blob-Hub-Event"
+	HeaderTimestamp = "X-Blob-Hub-Timestamp"
+	HeaderSignature = "X-Blob-Hub-Signature" // hex encoded HMAC-SHA256 of "{timestamp}.{body}" keyed by the endpoint secret
+
+	DeliverInterval  = 5 * time.Second
+	DeliverBatchSize = 100
+	DeliverTimeout   = 10 * time.Second
+	RetryBaseDelay   = 10 * time.Second
+	RetryMaxDelay    = 1 * time.Hour
+	PruneInterval    = 10 * time.Minute
+)
+
+// Notifier posts archive lifecycle events to webhook endpoints. Events are stored in the outbox table first, along with
+// the state change they report, and delivered with retries in the background, so that no event is lost across restarts.
+type Notifier struct {
+	outboxDB  db.OutboxDB
+	cfg       *config.NotifierConfig
+	chain     string
+	bucket    string
+	hc        *http.Client
+	endpoints map[string]*config.WebhookEndpoint
+}
+
+func NewNotifier(outboxDB db.OutboxDB, cfg *config.NotifierConfig, chain, bucketName string) *Notifier {
+	endpoints := make(map[string]*config.WebhookEndpoint)
+	for i := range cfg.Endpoints {
+		endpoints[cfg.Endpoints[i].URL] = &cfg.Endpoints[i]
+	}
+	return &Notifier{
+		outboxDB:  outboxDB,
+		cfg:       cfg,
+		chain:     chain,
+		bucket:    bucketName,
+		hc:        &http.Client{Timeout: DeliverTimeout},
+		endpoints: endpoints,
+	}
+}
+
+// Events returns the outbox rows of the event, one for every endpoint subscribing to it. They are meant to be saved in
+// the transaction changing the state the event reports, e.g. by db.BundleDB.UpdateBundleStatus, so that the event is
+// recorded if and only if the change is committed.
+func (n *Notifier) Events(eventType EventType, data interface{}) ([]*db.Outbox, error) {
+	now := time.Now().Unix()
+	payload, err := json.Marshal(&Event{
+		Type:       eventType,
+		Time:       now,
+		Chain:      n.chain,
+		BucketName: n.bucket,
+		Data:       data,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal event, type=%s, err=%s", eventType, err.Error())
+	}
+	events := make([]*db.Outbox, 0)
+	for _, endpoint := range n.cfg.Endpoints {
+		if !endpoint.Subscribed(string(eventType)) {
+			continue
+		}
+		events = append(events, &db.Outbox{
+			Endpoint:        endpoint.URL,
+			EventType:       string(eventType),
+			Payload:         string(payload),
+			Status:          db.OutboxPending,
+			NextAttemptTime: now,
+			CreatedTime:     now,
+		})
+	}
+	return events, nil
+}
+
+// Notify stores an event which does not come with a state change, like the alerts
+func (n *Notifier) Notify(eventType EventType, data interface{}) error {
+	events, err := n.Events(eventType, data)
+	if err != nil {
+		return err
+	}
+	if len(events) == 0 {
+		return nil
+	}
+	return n.outboxDB.CreateOutboxEvents(events)
+}
+
+// Start delivers the pending events and prunes the outbox in the background
+func (n *Notifier) Start() {
+	go func() {
+		deliverTicker := time.NewTicker(DeliverInterval)
+		for range deliverTicker.C {
+			if err := n.deliverPending(); err != nil {
+				logging.Logger.Errorf("failed to deliver events, err=%s", err.Error())
+			}
+		}
+	}()
+	go func() {
+		pruneTicker := time.NewTicker(PruneInterval)
+		for range pruneTicker.C {
+			deleted, err := n.outboxDB.DeleteOutboxEventsBefore(time.Now().Add(-n.cfg.GetOutboxRetention()).Unix())
+			if err != nil {
+				logging.Logger.Errorf("failed to prune outbox events, err=%s", err.Error())
+				continue
+			}
+			if deleted > 0 {
+				logging.Logger.Infof("pruned %d outbox events", deleted)
+			}
+		}
+	}()
+}
+
+func (n *Notifier) deliverPending() error {
+	events, err := n.outboxDB.GetPendingOutboxEvents(time.Now().Unix(), DeliverBatchSize)
+	if err != nil {
+		return err
+	}
+	for _, event := range events {
+		endpoint, ok := n.endpoints[event.Endpoint]
+		if !ok {
+			event.Status = db.OutboxFailed
+			event.LastError = "the endpoint is no longer configured"
+		} else if err = n.post(endpoint, event); err != nil {
+			event.Attempts++
+			event.LastError = err.Error()
+			if event.Attempts >= n.cfg.GetMaxAttempts() {
+				event.Status = db.OutboxFailed
+				logging.Logger.Errorf("give up delivering event %d to %s after %d attempts, err=%s", event.Id, event.Endpoint, event.Attempts, err.Error())
+			} else {
+				event.NextAttemptTime = time.Now().Add(retryDelay(event.Attempts)).Unix()
+			}
+		} else {
+			event.Attempts++
+			event.Status = db.OutboxDelivered
+			event.LastError = ""
+		}
+		if err = n.outboxDB.UpdateOutboxEvent(event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *Notifier) post(endpoint *config.WebhookEndpoint, event *db.Outbox) error {
+	timestamp := util.Int64ToString(time.Now().Unix())
+	req, err := http.NewRequest(http.MethodPost, endpoint.URL, bytes.NewBufferString(event.Payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, event.EventType)
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(endpoint.Secret, timestamp, []byte(event.Payload)))
+	resp, err := n.hc.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("received non-OK response status: %s", resp.Status)
+	}
+	return nil
+}
+
+// Sign returns the signature of a payload, receivers recompute it with the shared secret to authenticate the event
+func Sign(secret, timestamp string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// retryDelay doubles the delay on every failed attempt, capped by RetryMaxDelay
+func retryDelay(attempts int) time.Duration {
+	delay := RetryBaseDelay
+	for i := 1; i < attempts && delay < RetryMaxDelay; i++ {
+		delay *= 2
+	}
+	if delay > RetryMaxDelay {
+		return RetryMaxDelay
+	}
+	return delay
+}
@@ -21,6 +21,160 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type BlockStatus int32
+
+const (
+	BlockStatus_BLOCK_STATUS_PROCESSED BlockStatus = 0
+	// the blobs of the block are verified against the bundle service
+	BlockStatus_BLOCK_STATUS_VERIFIED BlockStatus = 1
+	BlockStatus_BLOCK_STATUS_SKIPPED  BlockStatus = 2
+)
+
+// Enum value maps for BlockStatus.
+var (
+	BlockStatus_name = map[int32]string{
+		0: "BLOCK_STATUS_PROCESSED",
+		1: "BLOCK_STATUS_VERIFIED",
+		2: "BLOCK_STATUS_SKIPPED",
+	}
+	BlockStatus_value = map[string]int32{
+		"BLOCK_STATUS_PROCESSED": 0,
+		"BLOCK_STATUS_VERIFIED":  1,
+		"BLOCK_STATUS_SKIPPED":   2,
+	}
+)
+
+func (x BlockStatus) Enum() *BlockStatus {
+	p := new(BlockStatus)
+	*p = x
+	return p
+}
+
+func (x BlockStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlockStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_blob_proto_enumTypes[0].Descriptor()
+}
+
+func (BlockStatus) Type() protoreflect.EnumType {
+	return &file_proto_blob_proto_enumTypes[0]
+}
+
+func (x BlockStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlockStatus.Descriptor instead.
+func (BlockStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{0}
+}
+
+type BundleStatus int32
+
+const (
+	BundleStatus_BUNDLE_STATUS_FINALIZING BundleStatus = 0
+	// the bundle is uploaded to the bundle service
+	BundleStatus_BUNDLE_STATUS_FINALIZED  BundleStatus = 1
+	BundleStatus_BUNDLE_STATUS_SEALED     BundleStatus = 2
+	BundleStatus_BUNDLE_STATUS_DEPRECATED BundleStatus = 3
+)
+
+// Enum value maps for BundleStatus.
+var (
+	BundleStatus_name = map[int32]string{
+		0: "BUNDLE_STATUS_FINALIZING",
+		1: "BUNDLE_STATUS_FINALIZED",
+		2: "BUNDLE_STATUS_SEALED",
+		3: "BUNDLE_STATUS_DEPRECATED",
+	}
+	BundleStatus_value = map[string]int32{
+		"BUNDLE_STATUS_FINALIZING": 0,
+		"BUNDLE_STATUS_FINALIZED":  1,
+		"BUNDLE_STATUS_SEALED":     2,
+		"BUNDLE_STATUS_DEPRECATED": 3,
+	}
+)
+
+func (x BundleStatus) Enum() *BundleStatus {
+	p := new(BundleStatus)
+	*p = x
+	return p
+}
+
+func (x BundleStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BundleStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_blob_proto_enumTypes[1].Descriptor()
+}
+
+func (BundleStatus) Type() protoreflect.EnumType {
+	return &file_proto_blob_proto_enumTypes[1]
+}
+
+func (x BundleStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BundleStatus.Descriptor instead.
+func (BundleStatus) EnumDescriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{1}
+}
+
+type BlockEventType int32
+
+const (
+	BlockEventType_BLOCK_EVENT_TYPE_UNSPECIFIED BlockEventType = 0
+	// the block and its blobs are archived
+	BlockEventType_BLOCK_EVENT_TYPE_ARCHIVED BlockEventType = 1
+	// the blobs of the block are verified against the bundle service
+	BlockEventType_BLOCK_EVENT_TYPE_VERIFIED BlockEventType = 2
+)
+
+// Enum value maps for BlockEventType.
+var (
+	BlockEventType_name = map[int32]string{
+		0: "BLOCK_EVENT_TYPE_UNSPECIFIED",
+		1: "BLOCK_EVENT_TYPE_ARCHIVED",
+		2: "BLOCK_EVENT_TYPE_VERIFIED",
+	}
+	BlockEventType_value = map[string]int32{
+		"BLOCK_EVENT_TYPE_UNSPECIFIED": 0,
+		"BLOCK_EVENT_TYPE_ARCHIVED":    1,
+		"BLOCK_EVENT_TYPE_VERIFIED":    2,
+	}
+)
+
+func (x BlockEventType) Enum() *BlockEventType {
+	p := new(BlockEventType)
+	*p = x
+	return p
+}
+
+func (x BlockEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BlockEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_proto_blob_proto_enumTypes[2].Descriptor()
+}
+
+func (BlockEventType) Type() protoreflect.EnumType {
+	return &file_proto_blob_proto_enumTypes[2]
+}
+
+func (x BlockEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BlockEventType.Descriptor instead.
+func (BlockEventType) EnumDescriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{2}
+}
+
 type GetBlobSidecarsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -82,6 +236,9 @@ type GetBlobSidecarsResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	Data []*SideCar `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	// the number of slots the archive lags the chain head by, only set for the head, finalized and genesis identifiers
+	// when the network is configured
+	ArchiveLag int64 `protobuf:"varint,2,opt,name=archive_lag,json=archiveLag,proto3" json:"archive_lag,omitempty"`
 }
 
 func (x *GetBlobSidecarsResponse) Reset() {
@@ -123,23 +280,251 @@ func (x *GetBlobSidecarsResponse) GetData() []*SideCar {
 	return nil
 }
 
+func (x *GetBlobSidecarsResponse) GetArchiveLag() int64 {
+	if x != nil {
+		return x.ArchiveLag
+	}
+	return 0
+}
+
+type GetBlobsByVersionedHashesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// up to 64 versioned hashes with 0x prefix
+	VersionedHashes []string `protobuf:"bytes,1,rep,name=versioned_hashes,json=versionedHashes,proto3" json:"versioned_hashes,omitempty"`
+}
+
+func (x *GetBlobsByVersionedHashesRequest) Reset() {
+	*x = GetBlobsByVersionedHashesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlobsByVersionedHashesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlobsByVersionedHashesRequest) ProtoMessage() {}
+
+func (x *GetBlobsByVersionedHashesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlobsByVersionedHashesRequest.ProtoReflect.Descriptor instead.
+func (*GetBlobsByVersionedHashesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetBlobsByVersionedHashesRequest) GetVersionedHashes() []string {
+	if x != nil {
+		return x.VersionedHashes
+	}
+	return nil
+}
+
+type GetBlobsByVersionedHashesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the blobs not archived are absent
+	Data []*VersionedBlob `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetBlobsByVersionedHashesResponse) Reset() {
+	*x = GetBlobsByVersionedHashesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlobsByVersionedHashesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlobsByVersionedHashesResponse) ProtoMessage() {}
+
+func (x *GetBlobsByVersionedHashesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlobsByVersionedHashesResponse.ProtoReflect.Descriptor instead.
+func (*GetBlobsByVersionedHashesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetBlobsByVersionedHashesResponse) GetData() []*VersionedBlob {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetBlobByVersionedHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// with 0x prefix
+	VersionedHash string `protobuf:"bytes,1,opt,name=versioned_hash,json=versionedHash,proto3" json:"versioned_hash,omitempty"`
+}
+
+func (x *GetBlobByVersionedHashRequest) Reset() {
+	*x = GetBlobByVersionedHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlobByVersionedHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlobByVersionedHashRequest) ProtoMessage() {}
+
+func (x *GetBlobByVersionedHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlobByVersionedHashRequest.ProtoReflect.Descriptor instead.
+func (*GetBlobByVersionedHashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetBlobByVersionedHashRequest) GetVersionedHash() string {
+	if x != nil {
+		return x.VersionedHash
+	}
+	return ""
+}
+
+type VersionedBlob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VersionedHash string `protobuf:"bytes,1,opt,name=versioned_hash,json=versionedHash,proto3" json:"versioned_hash,omitempty"`
+	// slot(ETH) or block number(BSC) of the blob
+	Slot    uint64   `protobuf:"varint,2,opt,name=slot,proto3" json:"slot,omitempty"`
+	TxHash  string   `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Sidecar *SideCar `protobuf:"bytes,4,opt,name=sidecar,proto3" json:"sidecar,omitempty"`
+}
+
+func (x *VersionedBlob) Reset() {
+	*x = VersionedBlob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VersionedBlob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionedBlob) ProtoMessage() {}
+
+func (x *VersionedBlob) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionedBlob.ProtoReflect.Descriptor instead.
+func (*VersionedBlob) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *VersionedBlob) GetVersionedHash() string {
+	if x != nil {
+		return x.VersionedHash
+	}
+	return ""
+}
+
+func (x *VersionedBlob) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *VersionedBlob) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *VersionedBlob) GetSidecar() *SideCar {
+	if x != nil {
+		return x.Sidecar
+	}
+	return nil
+}
+
 type SideCar struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Blob                        string                   `protobuf:"bytes,1,opt,name=blob,proto3" json:"blob,omitempty"`
-	Index                       string                   `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
-	KzgCommitment               string                   `protobuf:"bytes,3,opt,name=kzg_commitment,json=kzgCommitment,proto3" json:"kzg_commitment,omitempty"`
-	KzgCommitmentInclusionProof []string                 `protobuf:"bytes,4,rep,name=kzg_commitment_inclusion_proof,json=kzgCommitmentInclusionProof,proto3" json:"kzg_commitment_inclusion_proof,omitempty"`
-	KzgProof                    string                   `protobuf:"bytes,5,opt,name=kzg_proof,json=kzgProof,proto3" json:"kzg_proof,omitempty"`
-	SignedBlockHeader           *SignedBeaconBlockHeader `protobuf:"bytes,6,opt,name=signed_block_header,json=signedBlockHeader,proto3" json:"signed_block_header,omitempty"`
+	Blob                        string   `protobuf:"bytes,1,opt,name=blob,proto3" json:"blob,omitempty"`
+	Index                       string   `protobuf:"bytes,2,opt,name=index,proto3" json:"index,omitempty"`
+	KzgCommitment               string   `protobuf:"bytes,3,opt,name=kzg_commitment,json=kzgCommitment,proto3" json:"kzg_commitment,omitempty"`
+	KzgCommitmentInclusionProof []string `protobuf:"bytes,4,rep,name=kzg_commitment_inclusion_proof,json=kzgCommitmentInclusionProof,proto3" json:"kzg_commitment_inclusion_proof,omitempty"`
+	KzgProof                    string   `protobuf:"bytes,5,opt,name=kzg_proof,json=kzgProof,proto3" json:"kzg_proof,omitempty"`
+	// not set on BSC
+	SignedBlockHeader *SignedBeaconBlockHeader `protobuf:"bytes,6,opt,name=signed_block_header,json=signedBlockHeader,proto3" json:"signed_block_header,omitempty"`
+	TxHash            string                   `protobuf:"bytes,7,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	TxIndex           int64                    `protobuf:"varint,8,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// set for the sidecars of the blocks not archived yet, which are served from the chain
+	Unarchived bool `protobuf:"varint,9,opt,name=unarchived,proto3" json:"unarchived,omitempty"`
 }
 
 func (x *SideCar) Reset() {
 	*x = SideCar{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_blob_proto_msgTypes[2]
+		mi := &file_proto_blob_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -152,7 +537,7 @@ func (x *SideCar) String() string {
 func (*SideCar) ProtoMessage() {}
 
 func (x *SideCar) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_blob_proto_msgTypes[2]
+	mi := &file_proto_blob_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -165,7 +550,7 @@ func (x *SideCar) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SideCar.ProtoReflect.Descriptor instead.
 func (*SideCar) Descriptor() ([]byte, []int) {
-	return file_proto_blob_proto_rawDescGZIP(), []int{2}
+	return file_proto_blob_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *SideCar) GetBlob() string {
@@ -210,6 +595,27 @@ func (x *SideCar) GetSignedBlockHeader() *SignedBeaconBlockHeader {
 	return nil
 }
 
+func (x *SideCar) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *SideCar) GetTxIndex() int64 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *SideCar) GetUnarchived() bool {
+	if x != nil {
+		return x.Unarchived
+	}
+	return false
+}
+
 type SignedBeaconBlockHeader struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -222,7 +628,7 @@ type SignedBeaconBlockHeader struct {
 func (x *SignedBeaconBlockHeader) Reset() {
 	*x = SignedBeaconBlockHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_blob_proto_msgTypes[3]
+		mi := &file_proto_blob_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -235,7 +641,7 @@ func (x *SignedBeaconBlockHeader) String() string {
 func (*SignedBeaconBlockHeader) ProtoMessage() {}
 
 func (x *SignedBeaconBlockHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_blob_proto_msgTypes[3]
+	mi := &file_proto_blob_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -248,7 +654,7 @@ func (x *SignedBeaconBlockHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SignedBeaconBlockHeader.ProtoReflect.Descriptor instead.
 func (*SignedBeaconBlockHeader) Descriptor() ([]byte, []int) {
-	return file_proto_blob_proto_rawDescGZIP(), []int{3}
+	return file_proto_blob_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SignedBeaconBlockHeader) GetMessage() *BeaconBlockHeader {
@@ -280,7 +686,7 @@ type BeaconBlockHeader struct {
 func (x *BeaconBlockHeader) Reset() {
 	*x = BeaconBlockHeader{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_proto_blob_proto_msgTypes[4]
+		mi := &file_proto_blob_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -293,7 +699,7 @@ func (x *BeaconBlockHeader) String() string {
 func (*BeaconBlockHeader) ProtoMessage() {}
 
 func (x *BeaconBlockHeader) ProtoReflect() protoreflect.Message {
-	mi := &file_proto_blob_proto_msgTypes[4]
+	mi := &file_proto_blob_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -306,7 +712,7 @@ func (x *BeaconBlockHeader) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BeaconBlockHeader.ProtoReflect.Descriptor instead.
 func (*BeaconBlockHeader) Descriptor() ([]byte, []int) {
-	return file_proto_blob_proto_rawDescGZIP(), []int{4}
+	return file_proto_blob_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *BeaconBlockHeader) GetBodyRoot() string {
@@ -344,66 +750,1300 @@ func (x *BeaconBlockHeader) GetStateRoot() string {
 	return ""
 }
 
-var File_proto_blob_proto protoreflect.FileDescriptor
+type GetBSCBlobSidecarsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-var file_proto_blob_proto_rawDesc = []byte{
-	0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x04, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	// block number in decimal or 0x prefixed hex, 0x prefixed block hash, or one of head, finalized and genesis
+	BlockId string `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	// the blobs are cut to their first 32 bytes unless full_blob
+	FullBlob bool `protobuf:"varint,2,opt,name=full_blob,json=fullBlob,proto3" json:"full_blob,omitempty"`
+}
+
+func (x *GetBSCBlobSidecarsRequest) Reset() {
+	*x = GetBSCBlobSidecarsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBSCBlobSidecarsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBSCBlobSidecarsRequest) ProtoMessage() {}
+
+func (x *GetBSCBlobSidecarsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBSCBlobSidecarsRequest.ProtoReflect.Descriptor instead.
+func (*GetBSCBlobSidecarsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetBSCBlobSidecarsRequest) GetBlockId() string {
+	if x != nil {
+		return x.BlockId
+	}
+	return ""
+}
+
+func (x *GetBSCBlobSidecarsRequest) GetFullBlob() bool {
+	if x != nil {
+		return x.FullBlob
+	}
+	return false
+}
+
+type GetBSCBlobSidecarsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Data []*BSCBlobTxSidecar `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+}
+
+func (x *GetBSCBlobSidecarsResponse) Reset() {
+	*x = GetBSCBlobSidecarsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBSCBlobSidecarsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBSCBlobSidecarsResponse) ProtoMessage() {}
+
+func (x *GetBSCBlobSidecarsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBSCBlobSidecarsResponse.ProtoReflect.Descriptor instead.
+func (*GetBSCBlobSidecarsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetBSCBlobSidecarsResponse) GetData() []*BSCBlobTxSidecar {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetBSCBlobSidecarByTxHashRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// with 0x prefix
+	TxHash string `protobuf:"bytes,1,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// the blobs are cut to their first 32 bytes unless full_blob
+	FullBlob bool `protobuf:"varint,2,opt,name=full_blob,json=fullBlob,proto3" json:"full_blob,omitempty"`
+}
+
+func (x *GetBSCBlobSidecarByTxHashRequest) Reset() {
+	*x = GetBSCBlobSidecarByTxHashRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBSCBlobSidecarByTxHashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBSCBlobSidecarByTxHashRequest) ProtoMessage() {}
+
+func (x *GetBSCBlobSidecarByTxHashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBSCBlobSidecarByTxHashRequest.ProtoReflect.Descriptor instead.
+func (*GetBSCBlobSidecarByTxHashRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetBSCBlobSidecarByTxHashRequest) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *GetBSCBlobSidecarByTxHashRequest) GetFullBlob() bool {
+	if x != nil {
+		return x.FullBlob
+	}
+	return false
+}
+
+type BSCBlobTxSidecar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BlobSidecar *BSCBlobSidecar `protobuf:"bytes,1,opt,name=blob_sidecar,json=blobSidecar,proto3" json:"blob_sidecar,omitempty"`
+	BlockHash   string          `protobuf:"bytes,2,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	BlockNumber uint64          `protobuf:"varint,3,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
+	TxHash      string          `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	TxIndex     int64           `protobuf:"varint,5,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+}
+
+func (x *BSCBlobTxSidecar) Reset() {
+	*x = BSCBlobTxSidecar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BSCBlobTxSidecar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BSCBlobTxSidecar) ProtoMessage() {}
+
+func (x *BSCBlobTxSidecar) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BSCBlobTxSidecar.ProtoReflect.Descriptor instead.
+func (*BSCBlobTxSidecar) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *BSCBlobTxSidecar) GetBlobSidecar() *BSCBlobSidecar {
+	if x != nil {
+		return x.BlobSidecar
+	}
+	return nil
+}
+
+func (x *BSCBlobTxSidecar) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *BSCBlobTxSidecar) GetBlockNumber() uint64 {
+	if x != nil {
+		return x.BlockNumber
+	}
+	return 0
+}
+
+func (x *BSCBlobTxSidecar) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *BSCBlobTxSidecar) GetTxIndex() int64 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+type BSCBlobSidecar struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Blobs       []string `protobuf:"bytes,1,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	Commitments []string `protobuf:"bytes,2,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	Proofs      []string `protobuf:"bytes,3,rep,name=proofs,proto3" json:"proofs,omitempty"`
+}
+
+func (x *BSCBlobSidecar) Reset() {
+	*x = BSCBlobSidecar{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BSCBlobSidecar) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BSCBlobSidecar) ProtoMessage() {}
+
+func (x *BSCBlobSidecar) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BSCBlobSidecar.ProtoReflect.Descriptor instead.
+func (*BSCBlobSidecar) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BSCBlobSidecar) GetBlobs() []string {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+func (x *BSCBlobSidecar) GetCommitments() []string {
+	if x != nil {
+		return x.Commitments
+	}
+	return nil
+}
+
+func (x *BSCBlobSidecar) GetProofs() []string {
+	if x != nil {
+		return x.Proofs
+	}
+	return nil
+}
+
+type GetBlockMetaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// slot(ETH) or block number(BSC), 0x prefixed block root(ETH) or block hash(BSC), or one of head, finalized and genesis
+	BlockId string `protobuf:"bytes,1,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+}
+
+func (x *GetBlockMetaRequest) Reset() {
+	*x = GetBlockMetaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBlockMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBlockMetaRequest) ProtoMessage() {}
+
+func (x *GetBlockMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBlockMetaRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockMetaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetBlockMetaRequest) GetBlockId() string {
+	if x != nil {
+		return x.BlockId
+	}
+	return ""
+}
+
+type BlockMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// slot(ETH) or block number(BSC)
+	Slot uint64 `protobuf:"varint,1,opt,name=slot,proto3" json:"slot,omitempty"`
+	// not set on BSC
+	Root string `protobuf:"bytes,2,opt,name=root,proto3" json:"root,omitempty"`
+	// the hash of the eth1(ETH) or BSC block, not set for the blocks archived before schema version 5
+	BlockHash string `protobuf:"bytes,3,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// the eth1 block height, not set on BSC
+	ElBlockHeight uint64      `protobuf:"varint,4,opt,name=el_block_height,json=elBlockHeight,proto3" json:"el_block_height,omitempty"`
+	BlobCount     int64       `protobuf:"varint,5,opt,name=blob_count,json=blobCount,proto3" json:"blob_count,omitempty"`
+	BundleName    string      `protobuf:"bytes,6,opt,name=bundle_name,json=bundleName,proto3" json:"bundle_name,omitempty"`
+	Status        BlockStatus `protobuf:"varint,7,opt,name=status,proto3,enum=user.BlockStatus" json:"status,omitempty"`
+}
+
+func (x *BlockMeta) Reset() {
+	*x = BlockMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockMeta) ProtoMessage() {}
+
+func (x *BlockMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockMeta.ProtoReflect.Descriptor instead.
+func (*BlockMeta) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *BlockMeta) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *BlockMeta) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *BlockMeta) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *BlockMeta) GetElBlockHeight() uint64 {
+	if x != nil {
+		return x.ElBlockHeight
+	}
+	return 0
+}
+
+func (x *BlockMeta) GetBlobCount() int64 {
+	if x != nil {
+		return x.BlobCount
+	}
+	return 0
+}
+
+func (x *BlockMeta) GetBundleName() string {
+	if x != nil {
+		return x.BundleName
+	}
+	return ""
+}
+
+func (x *BlockMeta) GetStatus() BlockStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BlockStatus_BLOCK_STATUS_PROCESSED
+}
+
+type GetBundleMetaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BundleName string `protobuf:"bytes,1,opt,name=bundle_name,json=bundleName,proto3" json:"bundle_name,omitempty"`
+}
+
+func (x *GetBundleMetaRequest) Reset() {
+	*x = GetBundleMetaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetBundleMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetBundleMetaRequest) ProtoMessage() {}
+
+func (x *GetBundleMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetBundleMetaRequest.ProtoReflect.Descriptor instead.
+func (*GetBundleMetaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetBundleMetaRequest) GetBundleName() string {
+	if x != nil {
+		return x.BundleName
+	}
+	return ""
+}
+
+type BundleMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name   string       `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Status BundleStatus `protobuf:"varint,2,opt,name=status,proto3,enum=user.BundleStatus" json:"status,omitempty"`
+	// the first and last slot(ETH) or block(BSC) of the bundle
+	StartSlot uint64 `protobuf:"varint,3,opt,name=start_slot,json=startSlot,proto3" json:"start_slot,omitempty"`
+	EndSlot   uint64 `protobuf:"varint,4,opt,name=end_slot,json=endSlot,proto3" json:"end_slot,omitempty"`
+	// unix time in seconds
+	CreatedTime int64 `protobuf:"varint,5,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+}
+
+func (x *BundleMeta) Reset() {
+	*x = BundleMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BundleMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BundleMeta) ProtoMessage() {}
+
+func (x *BundleMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BundleMeta.ProtoReflect.Descriptor instead.
+func (*BundleMeta) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *BundleMeta) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *BundleMeta) GetStatus() BundleStatus {
+	if x != nil {
+		return x.Status
+	}
+	return BundleStatus_BUNDLE_STATUS_FINALIZING
+}
+
+func (x *BundleMeta) GetStartSlot() uint64 {
+	if x != nil {
+		return x.StartSlot
+	}
+	return 0
+}
+
+func (x *BundleMeta) GetEndSlot() uint64 {
+	if x != nil {
+		return x.EndSlot
+	}
+	return 0
+}
+
+func (x *BundleMeta) GetCreatedTime() int64 {
+	if x != nil {
+		return x.CreatedTime
+	}
+	return 0
+}
+
+type StreamBlobsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	From uint64 `protobuf:"varint,1,opt,name=from,proto3" json:"from,omitempty"`
+	To   uint64 `protobuf:"varint,2,opt,name=to,proto3" json:"to,omitempty"`
+	// the cursor of the last blob received, to resume a stream after it
+	Cursor string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+}
+
+func (x *StreamBlobsRequest) Reset() {
+	*x = StreamBlobsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamBlobsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamBlobsRequest) ProtoMessage() {}
+
+func (x *StreamBlobsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamBlobsRequest.ProtoReflect.Descriptor instead.
+func (*StreamBlobsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StreamBlobsRequest) GetFrom() uint64 {
+	if x != nil {
+		return x.From
+	}
+	return 0
+}
+
+func (x *StreamBlobsRequest) GetTo() uint64 {
+	if x != nil {
+		return x.To
+	}
+	return 0
+}
+
+func (x *StreamBlobsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type StreamedBlob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the position of the blob in the stream
+	Cursor        string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	VersionedHash string `protobuf:"bytes,2,opt,name=versioned_hash,json=versionedHash,proto3" json:"versioned_hash,omitempty"`
+	// slot(ETH) or block number(BSC) of the blob
+	Slot    uint64   `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	TxHash  string   `protobuf:"bytes,4,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	Sidecar *SideCar `protobuf:"bytes,5,opt,name=sidecar,proto3" json:"sidecar,omitempty"`
+}
+
+func (x *StreamedBlob) Reset() {
+	*x = StreamedBlob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamedBlob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamedBlob) ProtoMessage() {}
+
+func (x *StreamedBlob) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamedBlob.ProtoReflect.Descriptor instead.
+func (*StreamedBlob) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *StreamedBlob) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+func (x *StreamedBlob) GetVersionedHash() string {
+	if x != nil {
+		return x.VersionedHash
+	}
+	return ""
+}
+
+func (x *StreamedBlob) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *StreamedBlob) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *StreamedBlob) GetSidecar() *SideCar {
+	if x != nil {
+		return x.Sidecar
+	}
+	return nil
+}
+
+type SubscribeBlockEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the types of the events to send, all if not set
+	Types []BlockEventType `protobuf:"varint,1,rep,packed,name=types,proto3,enum=user.BlockEventType" json:"types,omitempty"`
+	// the id of the last event received to resume after it, the subscription starts at the latest event if not set
+	After string `protobuf:"bytes,2,opt,name=after,proto3" json:"after,omitempty"`
+}
+
+func (x *SubscribeBlockEventsRequest) Reset() {
+	*x = SubscribeBlockEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribeBlockEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeBlockEventsRequest) ProtoMessage() {}
+
+func (x *SubscribeBlockEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeBlockEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeBlockEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *SubscribeBlockEventsRequest) GetTypes() []BlockEventType {
+	if x != nil {
+		return x.Types
+	}
+	return nil
+}
+
+func (x *SubscribeBlockEventsRequest) GetAfter() string {
+	if x != nil {
+		return x.After
+	}
+	return ""
+}
+
+type BlockEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// the id of the event, to resume a subscription after it
+	Id   int64          `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type BlockEventType `protobuf:"varint,2,opt,name=type,proto3,enum=user.BlockEventType" json:"type,omitempty"`
+	// slot(ETH) or block number(BSC)
+	Slot uint64 `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	// not set on BSC and for the slots without a block
+	Root string `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty"`
+	// the hash of the eth1(ETH) or BSC block, not set for the blocks archived before schema version 5
+	BlockHash string `protobuf:"bytes,5,opt,name=block_hash,json=blockHash,proto3" json:"block_hash,omitempty"`
+	// the eth1 block height, not set on BSC
+	ElBlockHeight uint64      `protobuf:"varint,6,opt,name=el_block_height,json=elBlockHeight,proto3" json:"el_block_height,omitempty"`
+	BundleName    string      `protobuf:"bytes,7,opt,name=bundle_name,json=bundleName,proto3" json:"bundle_name,omitempty"`
+	BlobCount     int64       `protobuf:"varint,8,opt,name=blob_count,json=blobCount,proto3" json:"blob_count,omitempty"`
+	Blobs         []*BlobMeta `protobuf:"bytes,9,rep,name=blobs,proto3" json:"blobs,omitempty"`
+	// unix time in seconds the event is recorded at
+	Time int64 `protobuf:"varint,10,opt,name=time,proto3" json:"time,omitempty"`
+}
+
+func (x *BlockEvent) Reset() {
+	*x = BlockEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlockEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlockEvent) ProtoMessage() {}
+
+func (x *BlockEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlockEvent.ProtoReflect.Descriptor instead.
+func (*BlockEvent) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *BlockEvent) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *BlockEvent) GetType() BlockEventType {
+	if x != nil {
+		return x.Type
+	}
+	return BlockEventType_BLOCK_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *BlockEvent) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *BlockEvent) GetRoot() string {
+	if x != nil {
+		return x.Root
+	}
+	return ""
+}
+
+func (x *BlockEvent) GetBlockHash() string {
+	if x != nil {
+		return x.BlockHash
+	}
+	return ""
+}
+
+func (x *BlockEvent) GetElBlockHeight() uint64 {
+	if x != nil {
+		return x.ElBlockHeight
+	}
+	return 0
+}
+
+func (x *BlockEvent) GetBundleName() string {
+	if x != nil {
+		return x.BundleName
+	}
+	return ""
+}
+
+func (x *BlockEvent) GetBlobCount() int64 {
+	if x != nil {
+		return x.BlobCount
+	}
+	return 0
+}
+
+func (x *BlockEvent) GetBlobs() []*BlobMeta {
+	if x != nil {
+		return x.Blobs
+	}
+	return nil
+}
+
+func (x *BlockEvent) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type BlobMeta struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	VersionedHash string `protobuf:"bytes,1,opt,name=versioned_hash,json=versionedHash,proto3" json:"versioned_hash,omitempty"`
+	Index         int64  `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
+	TxHash        string `protobuf:"bytes,3,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	TxIndex       int64  `protobuf:"varint,4,opt,name=tx_index,json=txIndex,proto3" json:"tx_index,omitempty"`
+	// not set for the blobs archived before schema version 4
+	From          string `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To            string `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	KzgCommitment string `protobuf:"bytes,7,opt,name=kzg_commitment,json=kzgCommitment,proto3" json:"kzg_commitment,omitempty"`
+}
+
+func (x *BlobMeta) Reset() {
+	*x = BlobMeta{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_blob_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BlobMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BlobMeta) ProtoMessage() {}
+
+func (x *BlobMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_blob_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BlobMeta.ProtoReflect.Descriptor instead.
+func (*BlobMeta) Descriptor() ([]byte, []int) {
+	return file_proto_blob_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *BlobMeta) GetVersionedHash() string {
+	if x != nil {
+		return x.VersionedHash
+	}
+	return ""
+}
+
+func (x *BlobMeta) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *BlobMeta) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+func (x *BlobMeta) GetTxIndex() int64 {
+	if x != nil {
+		return x.TxIndex
+	}
+	return 0
+}
+
+func (x *BlobMeta) GetFrom() string {
+	if x != nil {
+		return x.From
+	}
+	return ""
+}
+
+func (x *BlobMeta) GetTo() string {
+	if x != nil {
+		return x.To
+	}
+	return ""
+}
+
+func (x *BlobMeta) GetKzgCommitment() string {
+	if x != nil {
+		return x.KzgCommitment
+	}
+	return ""
+}
+
+var File_proto_blob_proto protoreflect.FileDescriptor
+
+var file_proto_blob_proto_rawDesc = []byte{
+	0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x04, 0x75, 0x73, 0x65, 0x72, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4d, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f,
 	0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x09, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x69,
 	0x6e, 0x64, 0x69, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x69, 0x6e,
-	0x64, 0x69, 0x63, 0x65, 0x73, 0x22, 0x3c, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62,
+	0x64, 0x69, 0x63, 0x65, 0x73, 0x22, 0x5d, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62,
 	0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x21, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
 	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x43, 0x61, 0x72, 0x52, 0x04, 0x64,
-	0x61, 0x74, 0x61, 0x22, 0x8b, 0x02, 0x0a, 0x07, 0x53, 0x69, 0x64, 0x65, 0x43, 0x61, 0x72, 0x12,
-	0x12, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62,
-	0x6c, 0x6f, 0x62, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x6b, 0x7a, 0x67,
-	0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x6b, 0x7a, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
-	0x12, 0x43, 0x0a, 0x1e, 0x6b, 0x7a, 0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1b, 0x6b, 0x7a, 0x67, 0x43, 0x6f, 0x6d,
-	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f, 0x6e,
-	0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x7a, 0x67, 0x5f, 0x70, 0x72, 0x6f,
-	0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b, 0x7a, 0x67, 0x50, 0x72, 0x6f,
-	0x6f, 0x66, 0x12, 0x4d, 0x0a, 0x13, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x62, 0x6c, 0x6f,
-	0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x11,
-	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x22, 0x6a, 0x0a, 0x17, 0x53, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f,
-	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x07,
-	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x75, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
-	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xab, 0x01,
-	0x0a, 0x11, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
-	0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x72, 0x6f, 0x6f, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x62, 0x6f, 0x64, 0x79, 0x52, 0x6f, 0x6f, 0x74,
-	0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6f,
-	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f,
-	0x73, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65, 0x52, 0x6f, 0x6f, 0x74, 0x32, 0x8e, 0x01, 0x0a, 0x0b,
-	0x42, 0x6c, 0x6f, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7f, 0x0a, 0x0f, 0x47,
-	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x12, 0x1c,
-	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64,
-	0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x75,
-	0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63,
-	0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3, 0xe4,
-	0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65, 0x61,
-	0x63, 0x6f, 0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
-	0x73, 0x2f, 0x7b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x42, 0x08, 0x5a, 0x06,
-	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x61, 0x74, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x6c,
+	0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x4c, 0x61, 0x67, 0x22, 0x4d, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x73,
+	0x42, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x29, 0x0a, 0x10, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x0f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x61, 0x73,
+	0x68, 0x65, 0x73, 0x22, 0x4c, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x42,
+	0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x52, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x22, 0x46, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x42, 0x79, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x5f,
+	0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x22, 0x8c, 0x01, 0x0a, 0x0d, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x25, 0x0a, 0x0e, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x61,
+	0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x27, 0x0a, 0x07, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x43, 0x61, 0x72, 0x52,
+	0x07, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x22, 0xdf, 0x02, 0x0a, 0x07, 0x53, 0x69, 0x64,
+	0x65, 0x43, 0x61, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x62, 0x6c, 0x6f, 0x62, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x25,
+	0x0a, 0x0e, 0x6b, 0x7a, 0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x7a, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x0a, 0x1e, 0x6b, 0x7a, 0x67, 0x5f, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x1b, 0x6b,
+	0x7a, 0x67, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x63, 0x6c,
+	0x75, 0x73, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x1b, 0x0a, 0x09, 0x6b, 0x7a,
+	0x67, 0x5f, 0x70, 0x72, 0x6f, 0x6f, 0x66, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6b,
+	0x7a, 0x67, 0x50, 0x72, 0x6f, 0x6f, 0x66, 0x12, 0x4d, 0x0a, 0x13, 0x73, 0x69, 0x67, 0x6e, 0x65,
+	0x64, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x65, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61,
+	0x64, 0x65, 0x72, 0x52, 0x11, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x63, 0x6b,
+	0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12,
+	0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1e, 0x0a, 0x0a, 0x75, 0x6e,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a,
+	0x75, 0x6e, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x22, 0x6a, 0x0a, 0x17, 0x53, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x42, 0x65, 0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48,
+	0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x52,
+	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x69, 0x67,
+	0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x22, 0xab, 0x01, 0x0a, 0x11, 0x42, 0x65, 0x61, 0x63, 0x6f,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x1b, 0x0a, 0x09,
+	0x62, 0x6f, 0x64, 0x79, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x62, 0x6f, 0x64, 0x79, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72,
+	0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x70, 0x6f, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x65, 0x5f, 0x72,
+	0x6f, 0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x6f, 0x6f, 0x74, 0x22, 0x53, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x53, 0x43, 0x42, 0x6c,
+	0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09,
+	0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x66, 0x75, 0x6c, 0x6c, 0x42, 0x6c, 0x6f, 0x62, 0x22, 0x48, 0x0a, 0x1a, 0x47, 0x65, 0x74,
+	0x42, 0x53, 0x43, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2a, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x53, 0x43,
+	0x42, 0x6c, 0x6f, 0x62, 0x54, 0x78, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x61, 0x22, 0x58, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x42, 0x53, 0x43, 0x42, 0x6c, 0x6f,
+	0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x42, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x1b, 0x0a, 0x09, 0x66, 0x75, 0x6c, 0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x62, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x66, 0x75, 0x6c, 0x6c, 0x42, 0x6c, 0x6f, 0x62, 0x22, 0xc1, 0x01,
+	0x0a, 0x10, 0x42, 0x53, 0x43, 0x42, 0x6c, 0x6f, 0x62, 0x54, 0x78, 0x53, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x12, 0x37, 0x0a, 0x0c, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x63,
+	0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x42, 0x53, 0x43, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x52, 0x0b,
+	0x62, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x17, 0x0a,
+	0x07, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x22, 0x60, 0x0a, 0x0e, 0x42, 0x53, 0x43, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65,
+	0x63, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d,
+	0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70,
+	0x72, 0x6f, 0x6f, 0x66, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f,
+	0x6f, 0x66, 0x73, 0x22, 0x30, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d,
+	0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x49, 0x64, 0x22, 0xe5, 0x01, 0x0a, 0x09, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d,
+	0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x65, 0x6c,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69, 0x67,
+	0x68, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x62, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x11, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x37, 0x0a,
+	0x14, 0x47, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0xa9, 0x01, 0x0a, 0x0a, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x12, 0x2e, 0x75, 0x73, 0x65, 0x72,
+	0x2e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73,
+	0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x53, 0x6c, 0x6f, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x73, 0x6c, 0x6f, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x53, 0x6c, 0x6f, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69,
+	0x6d, 0x65, 0x22, 0x50, 0x0a, 0x12, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x62,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02,
+	0x74, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75,
+	0x72, 0x73, 0x6f, 0x72, 0x22, 0xa3, 0x01, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x65,
+	0x64, 0x42, 0x6c, 0x6f, 0x62, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x75, 0x72, 0x73, 0x6f, 0x72, 0x12, 0x25, 0x0a,
+	0x0e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64,
+	0x48, 0x61, 0x73, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x27, 0x0a, 0x07, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x69, 0x64, 0x65, 0x43, 0x61,
+	0x72, 0x52, 0x07, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x22, 0x5f, 0x0a, 0x1b, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x05, 0x74, 0x79, 0x70,
+	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x05,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x66, 0x74, 0x65, 0x72, 0x22, 0xaf, 0x02, 0x0a, 0x0a,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x28, 0x0a, 0x04, 0x74, 0x79,
+	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x14, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04,
+	0x74, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x73, 0x6c, 0x6f, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x6f, 0x6f, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x6f, 0x6f, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x0a, 0x0f, 0x65,
+	0x6c, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x65, 0x6c, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x62, 0x6c, 0x6f, 0x62, 0x43, 0x6f,
+	0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x6c, 0x6f, 0x62, 0x4d, 0x65,
+	0x74, 0x61, 0x52, 0x05, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22, 0xc6, 0x01,
+	0x0a, 0x08, 0x42, 0x6c, 0x6f, 0x62, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x78, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x78, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x07, 0x74, 0x78, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12,
+	0x0e, 0x0a, 0x02, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x12,
+	0x25, 0x0a, 0x0e, 0x6b, 0x7a, 0x67, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x6d, 0x65, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b, 0x7a, 0x67, 0x43, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x2a, 0x5e, 0x0a, 0x0b, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x16, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53,
+	0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x52, 0x4f, 0x43, 0x45, 0x53, 0x53, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x19, 0x0a, 0x15, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14,
+	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x53, 0x4b, 0x49,
+	0x50, 0x50, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x81, 0x01, 0x0a, 0x0c, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x18, 0x42, 0x55, 0x4e, 0x44, 0x4c,
+	0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a,
+	0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x17, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x53, 0x45, 0x41, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18,
+	0x42, 0x55, 0x4e, 0x44, 0x4c, 0x45, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x44, 0x45,
+	0x50, 0x52, 0x45, 0x43, 0x41, 0x54, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x70, 0x0a, 0x0e, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x20, 0x0a, 0x1c,
+	0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x1d,
+	0x0a, 0x19, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x41, 0x52, 0x43, 0x48, 0x49, 0x56, 0x45, 0x44, 0x10, 0x01, 0x12, 0x1d, 0x0a,
+	0x19, 0x42, 0x4c, 0x4f, 0x43, 0x4b, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x02, 0x32, 0xe3, 0x08, 0x0a,
+	0x0b, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x7f, 0x0a, 0x0f,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x12,
+	0x1c, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69,
+	0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e,
+	0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65,
+	0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x2f, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x29, 0x12, 0x27, 0x2f, 0x65, 0x74, 0x68, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x65,
+	0x61, 0x63, 0x6f, 0x6e, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61,
+	0x72, 0x73, 0x2f, 0x7b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x87, 0x01,
+	0x0a, 0x19, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x42, 0x79, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x26, 0x2e, 0x75, 0x73,
+	0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x42, 0x79, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x62, 0x73, 0x42, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x13, 0x12, 0x11, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x68, 0x75, 0x62, 0x2f, 0x76,
+	0x31, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x7e, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x42, 0x6c,
+	0x6f, 0x62, 0x42, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x61, 0x73,
+	0x68, 0x12, 0x23, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x62,
+	0x42, 0x79, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x48, 0x61, 0x73, 0x68, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x22, 0x2a, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31,
+	0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x2f, 0x7b, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x65,
+	0x64, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x12, 0x90, 0x01, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x42,
+	0x53, 0x43, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x12, 0x1f,
+	0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x53, 0x43, 0x42, 0x6c, 0x6f, 0x62,
+	0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x20, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x53, 0x43, 0x42, 0x6c, 0x6f,
+	0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x37, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x31, 0x12, 0x2f, 0x2f, 0x62, 0x6c, 0x6f, 0x62,
+	0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x73, 0x63, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x73, 0x2f, 0x7b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x62, 0x6c, 0x6f,
+	0x62, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x73, 0x12, 0x8f, 0x01, 0x0a, 0x19, 0x47,
+	0x65, 0x74, 0x42, 0x53, 0x43, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72,
+	0x42, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x12, 0x26, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x47, 0x65, 0x74, 0x42, 0x53, 0x43, 0x42, 0x6c, 0x6f, 0x62, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61,
+	0x72, 0x42, 0x79, 0x54, 0x78, 0x48, 0x61, 0x73, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x16, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x53, 0x43, 0x42, 0x6c, 0x6f, 0x62, 0x54,
+	0x78, 0x53, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x22, 0x32, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x2c,
+	0x12, 0x2a, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x73,
+	0x63, 0x2f, 0x74, 0x78, 0x73, 0x2f, 0x7b, 0x74, 0x78, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x7d, 0x2f,
+	0x62, 0x6c, 0x6f, 0x62, 0x5f, 0x73, 0x69, 0x64, 0x65, 0x63, 0x61, 0x72, 0x12, 0x61, 0x0a, 0x0c,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x12, 0x19, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x42,
+	0x6c, 0x6f, 0x63, 0x6b, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x25, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1f,
+	0x12, 0x1d, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x73, 0x2f, 0x7b, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x69, 0x64, 0x7d, 0x12,
+	0x68, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61,
+	0x12, 0x1a, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x47, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x4d, 0x65, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x75,
+	0x73, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x4d, 0x65, 0x74, 0x61, 0x22, 0x29,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x23, 0x12, 0x21, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x68, 0x75, 0x62,
+	0x2f, 0x76, 0x31, 0x2f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x62, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x7d, 0x12, 0x5f, 0x0a, 0x0b, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x12, 0x18, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x42, 0x6c, 0x6f, 0x62, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x65, 0x64, 0x42, 0x6c, 0x6f, 0x62, 0x22, 0x20, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x1a, 0x12, 0x18,
+	0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x73, 0x30, 0x01, 0x12, 0x76, 0x0a, 0x14, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x21, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72,
+	0x69, 0x62, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x75, 0x73, 0x65, 0x72, 0x2e, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x22, 0x27, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x21, 0x12,
+	0x1f, 0x2f, 0x62, 0x6c, 0x6f, 0x62, 0x68, 0x75, 0x62, 0x2f, 0x76, 0x31, 0x2f, 0x73, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x30, 0x01, 0x42, 0x08, 0x5a, 0x06, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -418,25 +2058,73 @@ func file_proto_blob_proto_rawDescGZIP() []byte {
 	return file_proto_blob_proto_rawDescData
 }
 
-var file_proto_blob_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_blob_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_proto_blob_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_proto_blob_proto_goTypes = []interface{}{
-	(*GetBlobSidecarsRequest)(nil),  // 0: user.GetBlobSidecarsRequest
-	(*GetBlobSidecarsResponse)(nil), // 1: user.GetBlobSidecarsResponse
-	(*SideCar)(nil),                 // 2: user.SideCar
-	(*SignedBeaconBlockHeader)(nil), // 3: user.SignedBeaconBlockHeader
-	(*BeaconBlockHeader)(nil),       // 4: user.BeaconBlockHeader
+	(BlockStatus)(0),                          // 0: user.BlockStatus
+	(BundleStatus)(0),                         // 1: user.BundleStatus
+	(BlockEventType)(0),                       // 2: user.BlockEventType
+	(*GetBlobSidecarsRequest)(nil),            // 3: user.GetBlobSidecarsRequest
+	(*GetBlobSidecarsResponse)(nil),           // 4: user.GetBlobSidecarsResponse
+	(*GetBlobsByVersionedHashesRequest)(nil),  // 5: user.GetBlobsByVersionedHashesRequest
+	(*GetBlobsByVersionedHashesResponse)(nil), // 6: user.GetBlobsByVersionedHashesResponse
+	(*GetBlobByVersionedHashRequest)(nil),     // 7: user.GetBlobByVersionedHashRequest
+	(*VersionedBlob)(nil),                     // 8: user.VersionedBlob
+	(*SideCar)(nil),                           // 9: user.SideCar
+	(*SignedBeaconBlockHeader)(nil),           // 10: user.SignedBeaconBlockHeader
+	(*BeaconBlockHeader)(nil),                 // 11: user.BeaconBlockHeader
+	(*GetBSCBlobSidecarsRequest)(nil),         // 12: user.GetBSCBlobSidecarsRequest
+	(*GetBSCBlobSidecarsResponse)(nil),        // 13: user.GetBSCBlobSidecarsResponse
+	(*GetBSCBlobSidecarByTxHashRequest)(nil),  // 14: user.GetBSCBlobSidecarByTxHashRequest
+	(*BSCBlobTxSidecar)(nil),                  // 15: user.BSCBlobTxSidecar
+	(*BSCBlobSidecar)(nil),                    // 16: user.BSCBlobSidecar
+	(*GetBlockMetaRequest)(nil),               // 17: user.GetBlockMetaRequest
+	(*BlockMeta)(nil),                         // 18: user.BlockMeta
+	(*GetBundleMetaRequest)(nil),              // 19: user.GetBundleMetaRequest
+	(*BundleMeta)(nil),                        // 20: user.BundleMeta
+	(*StreamBlobsRequest)(nil),                // 21: user.StreamBlobsRequest
+	(*StreamedBlob)(nil),                      // 22: user.StreamedBlob
+	(*SubscribeBlockEventsRequest)(nil),       // 23: user.SubscribeBlockEventsRequest
+	(*BlockEvent)(nil),                        // 24: user.BlockEvent
+	(*BlobMeta)(nil),                          // 25: user.BlobMeta
 }
 var file_proto_blob_proto_depIdxs = []int32{
-	2, // 0: user.GetBlobSidecarsResponse.data:type_name -> user.SideCar
-	3, // 1: user.SideCar.signed_block_header:type_name -> user.SignedBeaconBlockHeader
-	4, // 2: user.SignedBeaconBlockHeader.message:type_name -> user.BeaconBlockHeader
-	0, // 3: user.BlobService.GetBlobSidecars:input_type -> user.GetBlobSidecarsRequest
-	1, // 4: user.BlobService.GetBlobSidecars:output_type -> user.GetBlobSidecarsResponse
-	4, // [4:5] is the sub-list for method output_type
-	3, // [3:4] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	9,  // 0: user.GetBlobSidecarsResponse.data:type_name -> user.SideCar
+	8,  // 1: user.GetBlobsByVersionedHashesResponse.data:type_name -> user.VersionedBlob
+	9,  // 2: user.VersionedBlob.sidecar:type_name -> user.SideCar
+	10, // 3: user.SideCar.signed_block_header:type_name -> user.SignedBeaconBlockHeader
+	11, // 4: user.SignedBeaconBlockHeader.message:type_name -> user.BeaconBlockHeader
+	15, // 5: user.GetBSCBlobSidecarsResponse.data:type_name -> user.BSCBlobTxSidecar
+	16, // 6: user.BSCBlobTxSidecar.blob_sidecar:type_name -> user.BSCBlobSidecar
+	0,  // 7: user.BlockMeta.status:type_name -> user.BlockStatus
+	1,  // 8: user.BundleMeta.status:type_name -> user.BundleStatus
+	9,  // 9: user.StreamedBlob.sidecar:type_name -> user.SideCar
+	2,  // 10: user.SubscribeBlockEventsRequest.types:type_name -> user.BlockEventType
+	2,  // 11: user.BlockEvent.type:type_name -> user.BlockEventType
+	25, // 12: user.BlockEvent.blobs:type_name -> user.BlobMeta
+	3,  // 13: user.BlobService.GetBlobSidecars:input_type -> user.GetBlobSidecarsRequest
+	5,  // 14: user.BlobService.GetBlobsByVersionedHashes:input_type -> user.GetBlobsByVersionedHashesRequest
+	7,  // 15: user.BlobService.GetBlobByVersionedHash:input_type -> user.GetBlobByVersionedHashRequest
+	12, // 16: user.BlobService.GetBSCBlobSidecars:input_type -> user.GetBSCBlobSidecarsRequest
+	14, // 17: user.BlobService.GetBSCBlobSidecarByTxHash:input_type -> user.GetBSCBlobSidecarByTxHashRequest
+	17, // 18: user.BlobService.GetBlockMeta:input_type -> user.GetBlockMetaRequest
+	19, // 19: user.BlobService.GetBundleMeta:input_type -> user.GetBundleMetaRequest
+	21, // 20: user.BlobService.StreamBlobs:input_type -> user.StreamBlobsRequest
+	23, // 21: user.BlobService.SubscribeBlockEvents:input_type -> user.SubscribeBlockEventsRequest
+	4,  // 22: user.BlobService.GetBlobSidecars:output_type -> user.GetBlobSidecarsResponse
+	6,  // 23: user.BlobService.GetBlobsByVersionedHashes:output_type -> user.GetBlobsByVersionedHashesResponse
+	8,  // 24: user.BlobService.GetBlobByVersionedHash:output_type -> user.VersionedBlob
+	13, // 25: user.BlobService.GetBSCBlobSidecars:output_type -> user.GetBSCBlobSidecarsResponse
+	15, // 26: user.BlobService.GetBSCBlobSidecarByTxHash:output_type -> user.BSCBlobTxSidecar
+	18, // 27: user.BlobService.GetBlockMeta:output_type -> user.BlockMeta
+	20, // 28: user.BlobService.GetBundleMeta:output_type -> user.BundleMeta
+	22, // 29: user.BlobService.StreamBlobs:output_type -> user.StreamedBlob
+	24, // 30: user.BlobService.SubscribeBlockEvents:output_type -> user.BlockEvent
+	22, // [22:31] is the sub-list for method output_type
+	13, // [13:22] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_proto_blob_proto_init() }
@@ -470,7 +2158,7 @@ func file_proto_blob_proto_init() {
 			}
 		}
 		file_proto_blob_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SideCar); i {
+			switch v := v.(*GetBlobsByVersionedHashesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -482,7 +2170,7 @@ func file_proto_blob_proto_init() {
 			}
 		}
 		file_proto_blob_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SignedBeaconBlockHeader); i {
+			switch v := v.(*GetBlobsByVersionedHashesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -494,6 +2182,54 @@ func file_proto_blob_proto_init() {
 			}
 		}
 		file_proto_blob_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlobByVersionedHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionedBlob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SideCar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SignedBeaconBlockHeader); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*BeaconBlockHeader); i {
 			case 0:
 				return &v.state
@@ -505,19 +2241,188 @@ func file_proto_blob_proto_init() {
 				return nil
 			}
 		}
+		file_proto_blob_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBSCBlobSidecarsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBSCBlobSidecarsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBSCBlobSidecarByTxHashRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BSCBlobTxSidecar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BSCBlobSidecar); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBlockMetaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetBundleMetaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BundleMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamBlobsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamedBlob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeBlockEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlockEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_blob_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BlobMeta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_blob_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   5,
+			NumEnums:      3,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_proto_blob_proto_goTypes,
 		DependencyIndexes: file_proto_blob_proto_depIdxs,
+		EnumInfos:         file_proto_blob_proto_enumTypes,
 		MessageInfos:      file_proto_blob_proto_msgTypes,
 	}.Build()
 	File_proto_blob_proto = out.File
@@ -19,6 +19,18 @@ const _ = grpc.SupportPackageIsVersion7
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type BlobServiceClient interface {
 	GetBlobSidecars(ctx context.Context, in *GetBlobSidecarsRequest, opts ...grpc.CallOption) (*GetBlobSidecarsResponse, error)
+	GetBlobsByVersionedHashes(ctx context.Context, in *GetBlobsByVersionedHashesRequest, opts ...grpc.CallOption) (*GetBlobsByVersionedHashesResponse, error)
+	GetBlobByVersionedHash(ctx context.Context, in *GetBlobByVersionedHashRequest, opts ...grpc.CallOption) (*VersionedBlob, error)
+	// the blob sidecars of a BSC block grouped by tx, as eth_getBlobSidecars of BSC nodes returns them
+	GetBSCBlobSidecars(ctx context.Context, in *GetBSCBlobSidecarsRequest, opts ...grpc.CallOption) (*GetBSCBlobSidecarsResponse, error)
+	// the blob sidecar of a BSC tx, as eth_getBlobSidecarByTxHash of BSC nodes returns it
+	GetBSCBlobSidecarByTxHash(ctx context.Context, in *GetBSCBlobSidecarByTxHashRequest, opts ...grpc.CallOption) (*BSCBlobTxSidecar, error)
+	GetBlockMeta(ctx context.Context, in *GetBlockMetaRequest, opts ...grpc.CallOption) (*BlockMeta, error)
+	GetBundleMeta(ctx context.Context, in *GetBundleMetaRequest, opts ...grpc.CallOption) (*BundleMeta, error)
+	// the blobs of a range of slots(ETH) or blocks(BSC) in slot and index order
+	StreamBlobs(ctx context.Context, in *StreamBlobsRequest, opts ...grpc.CallOption) (BlobService_StreamBlobsClient, error)
+	// the blocks archived and verified by the syncer, as they are recorded until the client cancels
+	SubscribeBlockEvents(ctx context.Context, in *SubscribeBlockEventsRequest, opts ...grpc.CallOption) (BlobService_SubscribeBlockEventsClient, error)
 }
 
 type blobServiceClient struct {
@@ -38,11 +50,141 @@ func (c *blobServiceClient) GetBlobSidecars(ctx context.Context, in *GetBlobSide
 	return out, nil
 }
 
+func (c *blobServiceClient) GetBlobsByVersionedHashes(ctx context.Context, in *GetBlobsByVersionedHashesRequest, opts ...grpc.CallOption) (*GetBlobsByVersionedHashesResponse, error) {
+	out := new(GetBlobsByVersionedHashesResponse)
+	err := c.cc.Invoke(ctx, "/user.BlobService/GetBlobsByVersionedHashes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobServiceClient) GetBlobByVersionedHash(ctx context.Context, in *GetBlobByVersionedHashRequest, opts ...grpc.CallOption) (*VersionedBlob, error) {
+	out := new(VersionedBlob)
+	err := c.cc.Invoke(ctx, "/user.BlobService/GetBlobByVersionedHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobServiceClient) GetBSCBlobSidecars(ctx context.Context, in *GetBSCBlobSidecarsRequest, opts ...grpc.CallOption) (*GetBSCBlobSidecarsResponse, error) {
+	out := new(GetBSCBlobSidecarsResponse)
+	err := c.cc.Invoke(ctx, "/user.BlobService/GetBSCBlobSidecars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobServiceClient) GetBSCBlobSidecarByTxHash(ctx context.Context, in *GetBSCBlobSidecarByTxHashRequest, opts ...grpc.CallOption) (*BSCBlobTxSidecar, error) {
+	out := new(BSCBlobTxSidecar)
+	err := c.cc.Invoke(ctx, "/user.BlobService/GetBSCBlobSidecarByTxHash", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobServiceClient) GetBlockMeta(ctx context.Context, in *GetBlockMetaRequest, opts ...grpc.CallOption) (*BlockMeta, error) {
+	out := new(BlockMeta)
+	err := c.cc.Invoke(ctx, "/user.BlobService/GetBlockMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobServiceClient) GetBundleMeta(ctx context.Context, in *GetBundleMetaRequest, opts ...grpc.CallOption) (*BundleMeta, error) {
+	out := new(BundleMeta)
+	err := c.cc.Invoke(ctx, "/user.BlobService/GetBundleMeta", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *blobServiceClient) StreamBlobs(ctx context.Context, in *StreamBlobsRequest, opts ...grpc.CallOption) (BlobService_StreamBlobsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BlobService_ServiceDesc.Streams[0], "/user.BlobService/StreamBlobs", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blobServiceStreamBlobsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlobService_StreamBlobsClient interface {
+	Recv() (*StreamedBlob, error)
+	grpc.ClientStream
+}
+
+type blobServiceStreamBlobsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blobServiceStreamBlobsClient) Recv() (*StreamedBlob, error) {
+	m := new(StreamedBlob)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *blobServiceClient) SubscribeBlockEvents(ctx context.Context, in *SubscribeBlockEventsRequest, opts ...grpc.CallOption) (BlobService_SubscribeBlockEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &BlobService_ServiceDesc.Streams[1], "/user.BlobService/SubscribeBlockEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &blobServiceSubscribeBlockEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type BlobService_SubscribeBlockEventsClient interface {
+	Recv() (*BlockEvent, error)
+	grpc.ClientStream
+}
+
+type blobServiceSubscribeBlockEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *blobServiceSubscribeBlockEventsClient) Recv() (*BlockEvent, error) {
+	m := new(BlockEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // BlobServiceServer is the server API for BlobService service.
 // All implementations must embed UnimplementedBlobServiceServer
 // for forward compatibility
 type BlobServiceServer interface {
 	GetBlobSidecars(context.Context, *GetBlobSidecarsRequest) (*GetBlobSidecarsResponse, error)
+	GetBlobsByVersionedHashes(context.Context, *GetBlobsByVersionedHashesRequest) (*GetBlobsByVersionedHashesResponse, error)
+	GetBlobByVersionedHash(context.Context, *GetBlobByVersionedHashRequest) (*VersionedBlob, error)
+	// the blob sidecars of a BSC block grouped by tx, as eth_getBlobSidecars of BSC nodes returns them
+	GetBSCBlobSidecars(context.Context, *GetBSCBlobSidecarsRequest) (*GetBSCBlobSidecarsResponse, error)
+	// the blob sidecar of a BSC tx, as eth_getBlobSidecarByTxHash of BSC nodes returns it
+	GetBSCBlobSidecarByTxHash(context.Context, *GetBSCBlobSidecarByTxHashRequest) (*BSCBlobTxSidecar, error)
+	GetBlockMeta(context.Context, *GetBlockMetaRequest) (*BlockMeta, error)
+	GetBundleMeta(context.Context, *GetBundleMetaRequest) (*BundleMeta, error)
+	// the blobs of a range of slots(ETH) or blocks(BSC) in slot and index order
+	StreamBlobs(*StreamBlobsRequest, BlobService_StreamBlobsServer) error
+	// the blocks archived and verified by the syncer, as they are recorded until the client cancels
+	SubscribeBlockEvents(*SubscribeBlockEventsRequest, BlobService_SubscribeBlockEventsServer) error
 	mustEmbedUnimplementedBlobServiceServer()
 }
 
@@ -53,6 +195,30 @@ type UnimplementedBlobServiceServer struct {
 func (UnimplementedBlobServiceServer) GetBlobSidecars(context.Context, *GetBlobSidecarsRequest) (*GetBlobSidecarsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetBlobSidecars not implemented")
 }
+func (UnimplementedBlobServiceServer) GetBlobsByVersionedHashes(context.Context, *GetBlobsByVersionedHashesRequest) (*GetBlobsByVersionedHashesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlobsByVersionedHashes not implemented")
+}
+func (UnimplementedBlobServiceServer) GetBlobByVersionedHash(context.Context, *GetBlobByVersionedHashRequest) (*VersionedBlob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlobByVersionedHash not implemented")
+}
+func (UnimplementedBlobServiceServer) GetBSCBlobSidecars(context.Context, *GetBSCBlobSidecarsRequest) (*GetBSCBlobSidecarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBSCBlobSidecars not implemented")
+}
+func (UnimplementedBlobServiceServer) GetBSCBlobSidecarByTxHash(context.Context, *GetBSCBlobSidecarByTxHashRequest) (*BSCBlobTxSidecar, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBSCBlobSidecarByTxHash not implemented")
+}
+func (UnimplementedBlobServiceServer) GetBlockMeta(context.Context, *GetBlockMetaRequest) (*BlockMeta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBlockMeta not implemented")
+}
+func (UnimplementedBlobServiceServer) GetBundleMeta(context.Context, *GetBundleMetaRequest) (*BundleMeta, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBundleMeta not implemented")
+}
+func (UnimplementedBlobServiceServer) StreamBlobs(*StreamBlobsRequest, BlobService_StreamBlobsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamBlobs not implemented")
+}
+func (UnimplementedBlobServiceServer) SubscribeBlockEvents(*SubscribeBlockEventsRequest, BlobService_SubscribeBlockEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeBlockEvents not implemented")
+}
 func (UnimplementedBlobServiceServer) mustEmbedUnimplementedBlobServiceServer() {}
 
 // UnsafeBlobServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -84,6 +250,156 @@ func _BlobService_GetBlobSidecars_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _BlobService_GetBlobsByVersionedHashes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlobsByVersionedHashesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobServiceServer).GetBlobsByVersionedHashes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.BlobService/GetBlobsByVersionedHashes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobServiceServer).GetBlobsByVersionedHashes(ctx, req.(*GetBlobsByVersionedHashesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlobService_GetBlobByVersionedHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlobByVersionedHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobServiceServer).GetBlobByVersionedHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.BlobService/GetBlobByVersionedHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobServiceServer).GetBlobByVersionedHash(ctx, req.(*GetBlobByVersionedHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlobService_GetBSCBlobSidecars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBSCBlobSidecarsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobServiceServer).GetBSCBlobSidecars(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.BlobService/GetBSCBlobSidecars",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobServiceServer).GetBSCBlobSidecars(ctx, req.(*GetBSCBlobSidecarsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlobService_GetBSCBlobSidecarByTxHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBSCBlobSidecarByTxHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobServiceServer).GetBSCBlobSidecarByTxHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.BlobService/GetBSCBlobSidecarByTxHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobServiceServer).GetBSCBlobSidecarByTxHash(ctx, req.(*GetBSCBlobSidecarByTxHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlobService_GetBlockMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBlockMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobServiceServer).GetBlockMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.BlobService/GetBlockMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobServiceServer).GetBlockMeta(ctx, req.(*GetBlockMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlobService_GetBundleMeta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetBundleMetaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BlobServiceServer).GetBundleMeta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.BlobService/GetBundleMeta",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BlobServiceServer).GetBundleMeta(ctx, req.(*GetBundleMetaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BlobService_StreamBlobs_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StreamBlobsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlobServiceServer).StreamBlobs(m, &blobServiceStreamBlobsServer{stream})
+}
+
+type BlobService_StreamBlobsServer interface {
+	Send(*StreamedBlob) error
+	grpc.ServerStream
+}
+
+type blobServiceStreamBlobsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blobServiceStreamBlobsServer) Send(m *StreamedBlob) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _BlobService_SubscribeBlockEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeBlockEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BlobServiceServer).SubscribeBlockEvents(m, &blobServiceSubscribeBlockEventsServer{stream})
+}
+
+type BlobService_SubscribeBlockEventsServer interface {
+	Send(*BlockEvent) error
+	grpc.ServerStream
+}
+
+type blobServiceSubscribeBlockEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *blobServiceSubscribeBlockEventsServer) Send(m *BlockEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // BlobService_ServiceDesc is the grpc.ServiceDesc for BlobService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -95,7 +411,42 @@ var BlobService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetBlobSidecars",
 			Handler:    _BlobService_GetBlobSidecars_Handler,
 		},
+		{
+			MethodName: "GetBlobsByVersionedHashes",
+			Handler:    _BlobService_GetBlobsByVersionedHashes_Handler,
+		},
+		{
+			MethodName: "GetBlobByVersionedHash",
+			Handler:    _BlobService_GetBlobByVersionedHash_Handler,
+		},
+		{
+			MethodName: "GetBSCBlobSidecars",
+			Handler:    _BlobService_GetBSCBlobSidecars_Handler,
+		},
+		{
+			MethodName: "GetBSCBlobSidecarByTxHash",
+			Handler:    _BlobService_GetBSCBlobSidecarByTxHash_Handler,
+		},
+		{
+			MethodName: "GetBlockMeta",
+			Handler:    _BlobService_GetBlockMeta_Handler,
+		},
+		{
+			MethodName: "GetBundleMeta",
+			Handler:    _BlobService_GetBundleMeta_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamBlobs",
+			Handler:       _BlobService_StreamBlobs_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeBlockEvents",
+			Handler:       _BlobService_SubscribeBlockEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "proto/blob.proto",
 }
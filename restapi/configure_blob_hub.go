@@ -7,26 +7,31 @@ import (
 	"crypto/tls"
 	"fmt"
 	"log"
-	"net"
 	"net/http"
 	"os"
+	"strconv"
+	"sync"
+	"time"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/swag"
-	grpcruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
-	"google.golang.org/grpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/bnb-chain/blob-hub/cache"
-	"github.com/bnb-chain/blob-hub/client"
 	"github.com/bnb-chain/blob-hub/config"
 	syncerdb "github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/external"
 	"github.com/bnb-chain/blob-hub/external/cmn"
-	blobproto "github.com/bnb-chain/blob-hub/proto"
+	"github.com/bnb-chain/blob-hub/metrics"
 	"github.com/bnb-chain/blob-hub/restapi/handlers"
 	"github.com/bnb-chain/blob-hub/restapi/operations"
+	"github.com/bnb-chain/blob-hub/restapi/operations/beacon"
 	"github.com/bnb-chain/blob-hub/restapi/operations/blob"
 	"github.com/bnb-chain/blob-hub/service"
+	"github.com/bnb-chain/blob-hub/tracing"
 )
 
 //go:generate swagger generate server --target ../../blob-syncer --name BlobHub --spec ../swagger.yaml --principal interface{}
@@ -35,6 +40,21 @@ var cliOpts = struct {
 	ConfigFilePath string `short:"c" long:"config-path" description:"Config path" default:""`
 }{}
 
+var (
+	// configureServer is called once per serving scheme, the metrics and tracing are set up only once
+	observabilityOnce sync.Once
+	shutdownTracing   func(context.Context) error
+
+	// the gRPC server is started once too, along with the first serving scheme
+	grpcOnce sync.Once
+	grpcSrv  *grpcServer
+
+	// wsServer serves the websocket subscriptions on websocketPath of every serving scheme
+	wsServer = handlers.NewWebsocketServer()
+)
+
+const websocketPath = "/ws"
+
 func configureFlags(api *operations.BlobHubAPI) {
 	param := swag.CommandLineOptionsGroup{
 		ShortDescription: "config",
@@ -61,13 +81,40 @@ func configureAPI(api *operations.BlobHubAPI) http.Handler {
 
 	api.JSONProducer = runtime.JSONProducer()
 
+	api.BinProducer = runtime.ByteStreamProducer()
+
+	api.TextEventStreamProducer = runtime.TextProducer()
+
 	api.BlobGetBlobSidecarsByBlockNumHandler = blob.GetBlobSidecarsByBlockNumHandlerFunc(handlers.HandleGetBlobSidecars())
 	api.BlobGetBSCBlobSidecarsByBlockNumHandler = blob.GetBSCBlobSidecarsByBlockNumHandlerFunc(handlers.HandleGetBSCBlobSidecars())
-	api.PreServerShutdown = func() {}
-
-	api.ServerShutdown = func() {}
+	api.BlobGetBlobsByVersionedHashesHandler = blob.GetBlobsByVersionedHashesHandlerFunc(handlers.HandleGetBlobsByVersionedHashes())
+	api.BlobGetBlobsByTxHashHandler = blob.GetBlobsByTxHashHandlerFunc(handlers.HandleGetBlobsByTxHash())
+	api.BlobGetBlobsByAddressHandler = blob.GetBlobsByAddressHandlerFunc(handlers.HandleGetBlobsByAddress())
+	api.BlobStreamBlobsHandler = blob.StreamBlobsHandlerFunc(handlers.HandleStreamBlobs())
+	api.BlobSubscribeBlockEventsHandler = blob.SubscribeBlockEventsHandlerFunc(handlers.HandleSubscribeBlockEvents())
+	api.BeaconGetGenesisHandler = beacon.GetGenesisHandlerFunc(handlers.HandleGetGenesis())
+	api.BeaconGetSpecHandler = beacon.GetSpecHandlerFunc(handlers.HandleGetSpec())
+	api.BeaconGetNodeVersionHandler = beacon.GetNodeVersionHandlerFunc(handlers.HandleGetNodeVersion())
+	api.BeaconGetBlockHeaderHandler = beacon.GetBlockHeaderHandlerFunc(handlers.HandleGetBlockHeader())
+	api.PreServerShutdown = func() {
+		if grpcSrv != nil {
+			grpcSrv.drain()
+		}
+		// the hijacked websocket connections are not waited for by the shutdown, the subscribers reconnect elsewhere
+		wsServer.Stop()
+	}
 
-	go grpcServer()
+	api.ServerShutdown = func() {
+		if grpcSrv != nil {
+			grpcSrv.stop()
+		}
+		if shutdownTracing == nil {
+			return
+		}
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Println("Failed to shutdown tracing:", err)
+		}
+	}
 
 	return setupGlobalMiddleware(api.Serve(setupMiddlewares))
 }
@@ -96,77 +143,105 @@ func configureServer(s *http.Server, scheme, addr string) {
 		panic("failed to get configuration")
 	}
 	cfg.Validate()
-	db := config.InitDBWithConfig(&cfg.DBConfig, false)
+	observabilityOnce.Do(func() {
+		if cfg.MetricsConfig.Enable {
+			if cfg.MetricsConfig.HttpAddress == "" {
+				cfg.MetricsConfig.HttpAddress = metrics.DefaultMetricsAddress
+			}
+			metrics.NewServerMetrics(cfg.MetricsConfig.HttpAddress).Start()
+		}
+		shutdownTracing, err = tracing.Init(&cfg.TracingConfig, tracing.ServiceNameServer)
+		if err != nil {
+			panic(err)
+		}
+	})
+	db := config.InitDBWithConfig(&cfg.DBConfig)
+	if err = syncerdb.CheckSchemaVersion(db, syncerdb.ServerMinSchemaVersion, syncerdb.ServerMaxSchemaVersion); err != nil {
+		panic(err)
+	}
 	blobDB := syncerdb.NewBlobSvcDB(db)
 	bundleClient, err := cmn.NewBundleClient(cfg.BundleServiceEndpoints[0])
 	if err != nil {
 		panic(err)
 	}
 
-	switch cfg.CacheConfig.CacheType {
-	case "local":
-		cacheSvc, err = cache.NewLocalCache(cfg.CacheConfig.GetCacheSize())
+	cacheSvc, err = cache.NewLocalCache(cfg.CacheConfig.GetCacheSize())
+	if err != nil {
+		panic(err)
+	}
+	if cfg.CacheConfig.CacheType == config.CacheTypeRedis {
+		// the replicas archiving the same bucket share the entries
+		cacheSvc, err = cache.NewRedisCache(cfg.CacheConfig.URL, cfg.CacheConfig.GetTTL(), fmt.Sprintf("blob-hub:%s:", cfg.BucketName), cacheSvc)
 		if err != nil {
 			panic(err)
 		}
-	default:
-		panic("currently only local cache is support.")
 	}
-	service.BlobSvc = service.NewBlobService(blobDB, bundleClient, cacheSvc, cfg)
-
-}
-
-func grpcServer() {
-	lis, err := net.Listen("tcp", "0.0.0.0:9000")
-	if err != nil {
-		log.Fatalln("Failed to listen:", err)
+	var chainClient external.IClient
+	if cfg.LiveChainConfig.Enable {
+		chainClient = external.NewClient(&config.SyncerConfig{
+			Chain:          cfg.Chain,
+			RPCAddrs:       cfg.LiveChainConfig.RPCAddrs,
+			BeaconRPCAddrs: cfg.LiveChainConfig.BeaconRPCAddrs,
+		})
 	}
-
-	// Create a gRPC server object
-	s := grpc.NewServer()
-	// Attach the Blob service to the server
-	blobproto.RegisterBlobServiceServer(s, &client.BlobServer{})
-	// Serve gRPC server
-	log.Println("Serving gRPC on 0.0.0.0:9000")
-	go func() {
-		log.Fatalln(s.Serve(lis))
-	}()
-
-	maxMsgSize := 1024 * 1024 * 20
-	// Create a client connection to the gRPC server we just started
-	// This is where the gRPC-Gateway proxies the requests
-	conn, err := grpc.DialContext(
-		context.Background(),
-		"0.0.0.0:8080",
-		grpc.WithBlock(),
-		grpc.WithInsecure(), // nolint
-		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMsgSize), grpc.MaxCallSendMsgSize(maxMsgSize)),
-	)
-	if err != nil {
-		log.Fatalln("Failed to dial server:", err)
-	}
-	gwmux := grpcruntime.NewServeMux()
-	// Register User Service
-	err = blobproto.RegisterBlobServiceHandler(context.Background(), gwmux, conn)
-	if err != nil {
-		log.Fatalln("Failed to register gateway:", err)
+	service.BlobSvc = service.NewBlobService(blobDB, bundleClient, cacheSvc, chainClient, cfg)
+	service.BeaconSvc = service.NewBeaconService(blobDB, cfg)
+	if service.FeedSvc == nil {
+		// the subscribers of every serving scheme share the polling of the block events
+		service.FeedSvc = service.NewFeedService(blobDB, cfg)
 	}
-	gwServer := &http.Server{
-		Addr:    fmt.Sprintf(":%s", os.Getenv("server_port")),
-		Handler: gwmux,
-	}
-	log.Printf(fmt.Sprintf("Serving gRPC-Gateway on %s:%s", os.Getenv("server_host"), os.Getenv("server_port"))) // nolint
-	log.Fatalln(gwServer.ListenAndServe())
+
+	grpcOnce.Do(func() {
+		grpcSrv, err = startGRPCServer(&cfg.GRPCConfig)
+		if err != nil {
+			panic(err)
+		}
+	})
 }
 
 // The middleware configuration is for the handler executors. These do not apply to the swagger.json document.
 // The middleware executes after routing but before authentication, binding and validation.
 func setupMiddlewares(handler http.Handler) http.Handler {
-	return handler
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		handler.ServeHTTP(recorder, r)
+		// label by the route pattern rather than the path, so that block ids do not blow up the label cardinality
+		route := "unknown"
+		if matched := middleware.MatchedRouteFrom(r); matched != nil {
+			route = matched.PathPattern
+		}
+		trace.SpanFromContext(r.Context()).SetName(r.Method + " " + route)
+		metrics.RequestDurationHistogram.WithLabelValues(route, r.Method, strconv.Itoa(recorder.status)).Observe(time.Since(start).Seconds())
+	})
+}
+
+// statusRecorder keeps the status code written by the handler
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// Unwrap lets http.ResponseController flush the streamed responses through the recorder
+func (r *statusRecorder) Unwrap() http.ResponseWriter {
+	return r.ResponseWriter
 }
 
 // The middleware configuration happens before anything, this middleware also applies to serving the swagger.json document.
 // So this is a good place to plug in a panic handling middleware, logging and metrics.
 func setupGlobalMiddleware(handler http.Handler) http.Handler {
-	return handler
+	wsHandler := wsServer.WebsocketHandler([]string{"*"})
+	// the server span continues the W3C trace context of the request, it is renamed by the route once matched
+	return otelhttp.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == websocketPath {
+			wsHandler.ServeHTTP(w, r)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	}), "blob-hub")
 }
@@ -13,7 +13,10 @@
 //	  - application/json
 //
 //	Produces:
+//	  - application/octet-stream
 //	  - application/json
+//	  - application/x-ndjson
+//	  - text/event-stream
 //
 // swagger:meta
 package restapi
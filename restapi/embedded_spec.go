@@ -37,21 +37,20 @@ func init() {
         "tags": [
           "blob"
         ],
-        "summary": "Get BSC blob sidecars by block num",
+        "summary": "Get BSC blob sidecars by JSON-RPC, eth_getBlobSidecars and eth_getBlobSidecarByTxHash are supported",
         "operationId": "getBSCBlobSidecarsByBlockNum",
         "parameters": [
           {
+            "description": "A JSON-RPC request as RPCRequest, or a batch array of them",
             "name": "body",
             "in": "body",
             "required": true,
-            "schema": {
-              "$ref": "#/definitions/RPCRequest"
-            }
+            "schema": {}
           }
         ],
         "responses": {
           "200": {
-            "description": "successful operation",
+            "description": "successful operation, a batch array of RPCResponse for a batch request",
             "schema": {
               "$ref": "#/definitions/RPCResponse"
             }
@@ -65,7 +64,7 @@ func init() {
         }
       }
     },
-    "/eth/v1/beacon/blob_sidecars/{block_id}": {
+    "/blobhub/v1/addresses/{address}/blobs": {
       "get": {
         "produces": [
           "application/json"
@@ -73,32 +72,182 @@ func init() {
         "tags": [
           "blob"
         ],
-        "summary": "Get blob sidecars by block num",
-        "operationId": "getBlobSidecarsByBlockNum",
+        "summary": "Get the metadata of the blobs sent from or to an address, the latest first",
+        "operationId": "getBlobsByAddress",
         "parameters": [
           {
             "minLength": 1,
             "type": "string",
-            "description": "Block identifier. Can be one of: 'head' (canonical head in node's view), 'genesis', 'finalized', \u003cslot\u003e, \u003chex encoded blockRoot with 0x prefix\u003e",
-            "name": "block_id",
+            "description": "Address with 0x prefix",
+            "name": "address",
             "in": "path",
             "required": true
           },
           {
+            "enum": [
+              "from",
+              "to"
+            ],
+            "type": "string",
+            "description": "Side of the blob tx the address is on, 'from' (the sender) or 'to' (the recipient). Matches both if not specified",
+            "name": "role",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 1,
+            "description": "Page number starting from 1",
+            "name": "page",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 20,
+            "description": "Number of blobs per page",
+            "name": "page_size",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/GetBlobsByAddressResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "a dependency is temporarily unavailable, retry later",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/blobhub/v1/blobs": {
+      "get": {
+        "description": "Streams the blobs of [from, to] in slot and index order as NDJSON, one StreamedBlob per line ended by a line of {\"done\":true}, or as length-prefixed SSZ on ETH, each BlobSidecar prefixed by its 4-byte little-endian length and the stream ended by a zero length. A stream cut before its end can be resumed with the cursor of the last blob received.",
+        "produces": [
+          "application/x-ndjson",
+          "application/octet-stream",
+          "application/json"
+        ],
+        "tags": [
+          "blob"
+        ],
+        "summary": "Stream the blob sidecars of a slot range",
+        "operationId": "streamBlobs",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "uint64",
+            "description": "First slot(ETH) or block number(BSC) of the range",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "uint64",
+            "description": "Last slot(ETH) or block number(BSC) of the range, inclusive",
+            "name": "to",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Cursor of the last blob received, the stream resumes after it",
+            "name": "cursor",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/StreamedBlob"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "406": {
+            "description": "the requested content type is not supported",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "429": {
+            "description": "the archive storage is rate limiting the service, retry after the Retry-After header",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "a dependency is temporarily unavailable, retry later",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/blobhub/v1/blobs/{versioned_hash}": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "blob"
+        ],
+        "summary": "Get blob sidecars by versioned hashes",
+        "operationId": "getBlobsByVersionedHashes",
+        "parameters": [
+          {
+            "maxItems": 64,
+            "minItems": 1,
             "type": "array",
             "items": {
               "type": "string"
             },
-            "description": "Array of indices for blob sidecars to request for in the specified block. Returns all blob sidecars in the block if not specified",
-            "name": "indices",
-            "in": "query"
+            "collectionFormat": "csv",
+            "description": "Versioned hash of the blob with 0x prefix, or up to 64 comma separated versioned hashes",
+            "name": "versioned_hash",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "successful operation",
+            "description": "successful operation, the blobs not archived are absent from a batch",
             "schema": {
-              "$ref": "#/definitions/GetBlobSideCarsResponse"
+              "$ref": "#/definitions/GetBlobsByVersionedHashesResponse"
             }
           },
           "400": {
@@ -113,153 +262,732 @@ func init() {
               "$ref": "#/definitions/Error"
             }
           },
+          "429": {
+            "description": "the archive storage is rate limiting the service, retry after the Retry-After header",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
           "500": {
             "description": "internal server error",
             "schema": {
               "$ref": "#/definitions/Error"
             }
+          },
+          "503": {
+            "description": "a dependency is temporarily unavailable, retry later",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "BSCBlobSidecar": {
-      "type": "object",
-      "properties": {
-        "blobs": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
-        },
-        "commitments": {
-          "type": "array",
-          "items": {
-            "type": "string"
+    },
+    "/blobhub/v1/events": {
+      "get": {
+        "description": "Sends a Server-Sent Event for each block the syncer archives or verifies, of the event type and with the BlockEvent as its data, and a comment line as a keepalive. The id of an event resumes the subscription after it, by the Last-Event-ID header a reconnecting EventSource sends or the after parameter, as long as the event is within the retention of the syncer. Without either the subscription starts at the latest event.",
+        "produces": [
+          "text/event-stream",
+          "application/json"
+        ],
+        "tags": [
+          "blob"
+        ],
+        "summary": "Subscribe to the blocks archived and verified",
+        "operationId": "subscribeBlockEvents",
+        "parameters": [
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "block_archived",
+                "block_verified"
+              ],
+              "type": "string"
+            },
+            "collectionFormat": "csv",
+            "description": "Types of the events to send, all if not set",
+            "name": "types",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Id of the last event received, the subscription resumes after it",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Id of the last event received, sent by a reconnecting EventSource, it takes precedence over after",
+            "name": "Last-Event-ID",
+            "in": "header"
           }
-        },
-        "proofs": {
-          "type": "array",
-          "items": {
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/BlockEvent"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "the server has reached its maximum subscribers, retry later",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
     },
-    "BSCBlobTxSidecar": {
-      "type": "object",
-      "properties": {
-        "blobSidecar": {
-          "$ref": "#/definitions/BSCBlobSidecar"
-        },
-        "blockHash": {
-          "type": "string"
-        },
-        "blockNumber": {
-          "type": "string"
-        },
-        "txHash": {
-          "type": "string"
-        },
-        "txIndex": {
-          "type": "string"
+    "/blobhub/v1/txs/{tx_hash}/blobs": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "blob"
+        ],
+        "summary": "Get blob sidecars by tx hash",
+        "operationId": "getBlobsByTxHash",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "Hash of the blob tx with 0x prefix",
+            "name": "tx_hash",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/GetBlobsByTxHashResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "blob not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "429": {
+            "description": "the archive storage is rate limiting the service, retry after the Retry-After header",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "a dependency is temporarily unavailable, retry later",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "Error": {
-      "type": "object",
-      "properties": {
-        "code": {
-          "description": "HTTP error code",
-          "type": "integer",
-          "format": "int64",
-          "x-omitempty": false,
-          "example": "400/500"
-        },
-        "message": {
-          "description": "Error message",
-          "type": "string",
-          "x-omitempty": false,
-          "example": "Bad request/Internal server error"
+    "/eth/v1/beacon/blob_sidecars/{block_id}": {
+      "get": {
+        "produces": [
+          "application/json",
+          "application/octet-stream"
+        ],
+        "tags": [
+          "blob"
+        ],
+        "summary": "Get blob sidecars by block num",
+        "operationId": "getBlobSidecarsByBlockNum",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "Block identifier. Can be one of: 'head' (the latest archived block), 'genesis' (the first archived block), 'finalized' (the latest verified block), \u003cslot\u003e, \u003chex encoded blockRoot with 0x prefix\u003e",
+            "name": "block_id",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "description": "Array of indices for blob sidecars to request for in the specified block. Returns all blob sidecars in the block if not specified",
+            "name": "indices",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation, the sidecars are SSZ encoded when application/octet-stream is accepted",
+            "schema": {
+              "$ref": "#/definitions/GetBlobSideCarsResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "blob not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "406": {
+            "description": "SSZ encoding is not acceptable on the chain",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "429": {
+            "description": "the archive storage is rate limiting the service, retry after the Retry-After header",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "a dependency is temporarily unavailable, retry later",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
         }
       }
     },
-    "GetBlobSideCarsResponse": {
+    "/eth/v1/beacon/genesis": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "beacon"
+        ],
+        "summary": "Get the genesis of the configured network",
+        "operationId": "getGenesis",
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/GetGenesisResponse"
+            }
+          },
+          "404": {
+            "description": "network not configured",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/eth/v1/beacon/headers/{block_id}": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "beacon"
+        ],
+        "summary": "Get the header of an archived block",
+        "operationId": "getBlockHeader",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "Block identifier. Can be one of: 'head' (the latest archived block), 'genesis' (the first archived block), 'finalized' (the latest verified block), \u003cslot\u003e, \u003chex encoded blockRoot with 0x prefix\u003e",
+            "name": "block_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/GetBlockHeaderResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "block not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "a dependency is temporarily unavailable, retry later",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/eth/v1/config/spec": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "beacon"
+        ],
+        "summary": "Get the spec values of the configured network",
+        "operationId": "getSpec",
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/GetSpecResponse"
+            }
+          },
+          "404": {
+            "description": "network not configured",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/eth/v1/node/version": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "beacon"
+        ],
+        "summary": "Get the version of the blob hub",
+        "operationId": "getNodeVersion",
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/GetNodeVersionResponse"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "BSCBlobSidecar": {
       "type": "object",
       "properties": {
-        "code": {
-          "description": "status code",
-          "type": "integer",
-          "example": 200
+        "blobs": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         },
-        "data": {
-          "description": "actual data for request",
+        "commitments": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/Sidecar"
+            "type": "string"
           }
         },
-        "message": {
-          "description": "error message if there is error",
-          "type": "string",
-          "example": "signature invalid"
+        "proofs": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
         }
       }
     },
-    "RPCError": {
+    "BSCBlobTxSidecar": {
       "type": "object",
       "properties": {
-        "code": {
-          "description": "RPC error code",
-          "type": "integer",
-          "format": "int64",
-          "x-omitempty": false,
-          "example": -32602
+        "blobSidecar": {
+          "$ref": "#/definitions/BSCBlobSidecar"
         },
-        "message": {
-          "description": "Error message",
-          "type": "string",
-          "x-omitempty": false,
-          "example": "Invalid params"
+        "blockHash": {
+          "type": "string"
+        },
+        "blockNumber": {
+          "type": "string"
+        },
+        "txHash": {
+          "type": "string"
+        },
+        "txIndex": {
+          "type": "string"
         }
       }
     },
-    "RPCRequest": {
+    "BlobMeta": {
       "type": "object",
       "properties": {
-        "id": {
-          "type": "integer",
-          "example": 1
+        "from": {
+          "description": "sender of the blob tx, empty for the blobs archived before it was recorded",
+          "type": "string"
         },
-        "jsonrpc": {
+        "index": {
           "type": "string",
-          "example": "2.0"
+          "example": "0"
         },
-        "method": {
+        "kzg_commitment": {
+          "type": "string"
+        },
+        "slot": {
+          "description": "slot(ETH) or block number(BSC) of the blob",
           "type": "string",
-          "example": "eth_getBlobSidecars"
+          "example": "8783262"
         },
-        "params": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "example": [
-            "0x1",
-            true
-          ]
+        "to": {
+          "type": "string"
+        },
+        "tx_hash": {
+          "type": "string"
+        },
+        "tx_index": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "versioned_hash": {
+          "type": "string"
         }
       }
     },
-    "RPCResponse": {
+    "BlockEvent": {
       "type": "object",
       "properties": {
-        "error": {
-          "$ref": "#/definitions/RPCError"
+        "blob_count": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "blobs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BlobMeta"
+          }
+        },
+        "block_hash": {
+          "description": "hash of the execution(ETH) or BSC block, empty for the blocks archived before it was recorded",
+          "type": "string"
+        },
+        "block_id": {
+          "description": "slot(ETH) or block number(BSC) of the block",
+          "type": "string",
+          "example": "8783262"
+        },
+        "bundle_name": {
+          "type": "string"
+        },
+        "el_block_height": {
+          "description": "height of the execution block, empty on BSC",
+          "type": "string"
         },
         "id": {
+          "description": "id of the event, a subscription resumes after it",
+          "type": "integer",
+          "format": "int64"
+        },
+        "root": {
+          "description": "root of the beacon block, empty on BSC and for the slots without a block",
+          "type": "string"
+        },
+        "time": {
+          "description": "unix time the event is recorded at",
+          "type": "integer",
+          "format": "int64"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "block_archived",
+            "block_verified"
+          ]
+        }
+      }
+    },
+    "BlockHeader": {
+      "type": "object",
+      "properties": {
+        "canonical": {
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "header": {
+          "$ref": "#/definitions/SignedBeaconBlockHeader"
+        },
+        "root": {
+          "type": "string"
+        }
+      }
+    },
+    "Error": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "description": "HTTP error code",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false,
+          "example": "400/500"
+        },
+        "message": {
+          "description": "Error message",
+          "type": "string",
+          "x-omitempty": false,
+          "example": "Bad request/Internal server error"
+        },
+        "reason": {
+          "description": "Machine-readable reason of the error",
+          "type": "string",
+          "enum": [
+            "INVALID_ARGUMENT",
+            "NOT_ACCEPTABLE",
+            "NOT_SUPPORTED",
+            "BLOCK_NOT_FOUND",
+            "NOT_ARCHIVED_YET",
+            "BLOB_NOT_FOUND",
+            "BUNDLE_NOT_FOUND",
+            "RATE_LIMITED",
+            "UNAVAILABLE",
+            "INTERNAL"
+          ],
+          "example": "BLOCK_NOT_FOUND"
+        }
+      }
+    },
+    "Genesis": {
+      "type": "object",
+      "properties": {
+        "genesis_fork_version": {
+          "type": "string",
+          "example": "0x00000000"
+        },
+        "genesis_time": {
+          "type": "string",
+          "example": "1606824023"
+        },
+        "genesis_validators_root": {
+          "type": "string",
+          "example": "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"
+        }
+      }
+    },
+    "GetBlobSideCarsResponse": {
+      "type": "object",
+      "properties": {
+        "archive_lag": {
+          "description": "number of slots the archive lags the chain head by, only set when the block is identified by 'head', 'finalized' or 'genesis' and the network is configured",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "code": {
+          "description": "status code",
+          "type": "integer",
+          "example": 200
+        },
+        "data": {
+          "description": "actual data for request",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/Sidecar"
+          }
+        },
+        "message": {
+          "description": "error message if there is error",
+          "type": "string",
+          "example": "signature invalid"
+        }
+      }
+    },
+    "GetBlobsByAddressResponse": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BlobMeta"
+          }
+        },
+        "has_more": {
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "page": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "page_size": {
           "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "GetBlobsByTxHashResponse": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VersionedBlob"
+          }
+        }
+      }
+    },
+    "GetBlobsByVersionedHashesResponse": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VersionedBlob"
+          }
+        }
+      }
+    },
+    "GetBlockHeaderResponse": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/BlockHeader"
+        },
+        "execution_optimistic": {
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "finalized": {
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
+    "GetGenesisResponse": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/Genesis"
+        }
+      }
+    },
+    "GetNodeVersionResponse": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "type": "object",
+          "properties": {
+            "version": {
+              "type": "string",
+              "example": "BlobHub/v1.0.0-5e0a2d4/linux-amd64"
+            }
+          }
+        }
+      }
+    },
+    "GetSpecResponse": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "RPCError": {
+      "type": "object",
+      "properties": {
+        "code": {
+          "description": "RPC error code",
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false,
+          "example": -32602
+        },
+        "message": {
+          "description": "Error message",
+          "type": "string",
+          "x-omitempty": false,
+          "example": "Invalid params"
+        }
+      }
+    },
+    "RPCRequest": {
+      "type": "object",
+      "properties": {
+        "id": {
+          "description": "number or string identifier of the request",
+          "example": 1
+        },
+        "jsonrpc": {
+          "type": "string",
+          "example": "2.0"
+        },
+        "method": {
+          "type": "string",
+          "example": "eth_getBlobSidecars"
+        },
+        "params": {
+          "type": "array",
+          "items": {},
+          "example": [
+            "0x1",
+            true
+          ]
+        }
+      }
+    },
+    "RPCResponse": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "$ref": "#/definitions/RPCError"
+        },
+        "id": {
+          "description": "identifier of the request",
+          "x-omitempty": false,
           "example": 1
         },
         "jsonrpc": {
@@ -267,113 +995,531 @@ func init() {
           "example": "2.0"
         },
         "result": {
+          "description": "array of BSCBlobTxSidecar for eth_getBlobSidecars, a BSCBlobTxSidecar for eth_getBlobSidecarByTxHash, or null if not found",
+          "x-omitempty": false
+        }
+      }
+    },
+    "Sidecar": {
+      "type": "object",
+      "properties": {
+        "blob": {
+          "type": "string"
+        },
+        "index": {
+          "type": "string",
+          "example": 1
+        },
+        "kzg_commitment": {
+          "type": "string"
+        },
+        "kzg_commitment_inclusion_proof": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/BSCBlobTxSidecar"
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "kzg_proof": {
+          "type": "string"
+        },
+        "signed_block_header": {
+          "type": "object",
+          "properties": {
+            "message": {
+              "type": "object",
+              "properties": {
+                "body_root": {
+                  "type": "string"
+                },
+                "parent_root": {
+                  "type": "string"
+                },
+                "proposer_index": {
+                  "type": "string"
+                },
+                "slot": {
+                  "type": "string"
+                },
+                "state_root": {
+                  "type": "string"
+                }
+              }
+            },
+            "signature": {
+              "type": "string"
+            }
+          }
+        },
+        "tx_hash": {
+          "type": "string"
+        },
+        "tx_index": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": true
+        },
+        "unarchived": {
+          "description": "the blob is not archived yet, it is served from the chain after its KZG proof is verified",
+          "type": "boolean",
+          "x-omitempty": true
+        }
+      }
+    },
+    "SignedBeaconBlockHeader": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "object",
+          "properties": {
+            "body_root": {
+              "type": "string"
+            },
+            "parent_root": {
+              "type": "string"
+            },
+            "proposer_index": {
+              "type": "string"
+            },
+            "slot": {
+              "type": "string"
+            },
+            "state_root": {
+              "type": "string"
+            }
+          }
+        },
+        "signature": {
+          "type": "string"
+        }
+      }
+    },
+    "StreamedBlob": {
+      "type": "object",
+      "properties": {
+        "cursor": {
+          "description": "cursor to resume the stream after the blob",
+          "type": "string",
+          "example": "8783262:1"
+        },
+        "sidecar": {
+          "$ref": "#/definitions/Sidecar"
+        },
+        "slot": {
+          "description": "slot(ETH) or block number(BSC) of the blob",
+          "type": "string",
+          "example": "8783262"
+        },
+        "tx_hash": {
+          "type": "string"
+        },
+        "versioned_hash": {
+          "type": "string"
+        }
+      }
+    },
+    "VersionedBlob": {
+      "type": "object",
+      "properties": {
+        "sidecar": {
+          "$ref": "#/definitions/Sidecar"
+        },
+        "slot": {
+          "description": "slot(ETH) or block number(BSC) of the blob",
+          "type": "string",
+          "example": "8783262"
+        },
+        "tx_hash": {
+          "type": "string"
+        },
+        "versioned_hash": {
+          "type": "string"
+        }
+      }
+    }
+  }
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "API for handling blob query in the Blob Hub.",
+    "title": "Blob Hub Service API",
+    "version": "1.0.0"
+  },
+  "host": "blob-hub",
+  "paths": {
+    "/": {
+      "post": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "blob"
+        ],
+        "summary": "Get BSC blob sidecars by JSON-RPC, eth_getBlobSidecars and eth_getBlobSidecarByTxHash are supported",
+        "operationId": "getBSCBlobSidecarsByBlockNum",
+        "parameters": [
+          {
+            "description": "A JSON-RPC request as RPCRequest, or a batch array of them",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {}
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation, a batch array of RPCResponse for a batch request",
+            "schema": {
+              "$ref": "#/definitions/RPCResponse"
+            }
+          },
+          "500": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/blobhub/v1/addresses/{address}/blobs": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "blob"
+        ],
+        "summary": "Get the metadata of the blobs sent from or to an address, the latest first",
+        "operationId": "getBlobsByAddress",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "Address with 0x prefix",
+            "name": "address",
+            "in": "path",
+            "required": true
+          },
+          {
+            "enum": [
+              "from",
+              "to"
+            ],
+            "type": "string",
+            "description": "Side of the blob tx the address is on, 'from' (the sender) or 'to' (the recipient). Matches both if not specified",
+            "name": "role",
+            "in": "query"
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 1,
+            "description": "Page number starting from 1",
+            "name": "page",
+            "in": "query"
+          },
+          {
+            "maximum": 100,
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "default": 20,
+            "description": "Number of blobs per page",
+            "name": "page_size",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/GetBlobsByAddressResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "a dependency is temporarily unavailable, retry later",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/blobhub/v1/blobs": {
+      "get": {
+        "description": "Streams the blobs of [from, to] in slot and index order as NDJSON, one StreamedBlob per line ended by a line of {\"done\":true}, or as length-prefixed SSZ on ETH, each BlobSidecar prefixed by its 4-byte little-endian length and the stream ended by a zero length. A stream cut before its end can be resumed with the cursor of the last blob received.",
+        "produces": [
+          "application/json",
+          "application/octet-stream",
+          "application/x-ndjson"
+        ],
+        "tags": [
+          "blob"
+        ],
+        "summary": "Stream the blob sidecars of a slot range",
+        "operationId": "streamBlobs",
+        "parameters": [
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "uint64",
+            "description": "First slot(ETH) or block number(BSC) of the range",
+            "name": "from",
+            "in": "query",
+            "required": true
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "uint64",
+            "description": "Last slot(ETH) or block number(BSC) of the range, inclusive",
+            "name": "to",
+            "in": "query",
+            "required": true
+          },
+          {
+            "type": "string",
+            "description": "Cursor of the last blob received, the stream resumes after it",
+            "name": "cursor",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/StreamedBlob"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "406": {
+            "description": "the requested content type is not supported",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "429": {
+            "description": "the archive storage is rate limiting the service, retry after the Retry-After header",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "a dependency is temporarily unavailable, retry later",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/blobhub/v1/blobs/{versioned_hash}": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "blob"
+        ],
+        "summary": "Get blob sidecars by versioned hashes",
+        "operationId": "getBlobsByVersionedHashes",
+        "parameters": [
+          {
+            "maxItems": 64,
+            "minItems": 1,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "csv",
+            "description": "Versioned hash of the blob with 0x prefix, or up to 64 comma separated versioned hashes",
+            "name": "versioned_hash",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation, the blobs not archived are absent from a batch",
+            "schema": {
+              "$ref": "#/definitions/GetBlobsByVersionedHashesResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "blob not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "429": {
+            "description": "the archive storage is rate limiting the service, retry after the Retry-After header",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "a dependency is temporarily unavailable, retry later",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
     },
-    "Sidecar": {
-      "type": "object",
-      "properties": {
-        "blob": {
-          "type": "string"
-        },
-        "index": {
-          "type": "string",
-          "example": 1
-        },
-        "kzg_commitment": {
-          "type": "string"
-        },
-        "kzg_commitment_inclusion_proof": {
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "x-omitempty": true
-        },
-        "kzg_proof": {
-          "type": "string"
-        },
-        "signed_block_header": {
-          "type": "object",
-          "properties": {
-            "message": {
-              "type": "object",
-              "properties": {
-                "body_root": {
-                  "type": "string"
-                },
-                "parent_root": {
-                  "type": "string"
-                },
-                "proposer_index": {
-                  "type": "string"
-                },
-                "slot": {
-                  "type": "string"
-                },
-                "state_root": {
-                  "type": "string"
-                }
-              }
-            },
-            "signature": {
+    "/blobhub/v1/events": {
+      "get": {
+        "description": "Sends a Server-Sent Event for each block the syncer archives or verifies, of the event type and with the BlockEvent as its data, and a comment line as a keepalive. The id of an event resumes the subscription after it, by the Last-Event-ID header a reconnecting EventSource sends or the after parameter, as long as the event is within the retention of the syncer. Without either the subscription starts at the latest event.",
+        "produces": [
+          "application/json",
+          "text/event-stream"
+        ],
+        "tags": [
+          "blob"
+        ],
+        "summary": "Subscribe to the blocks archived and verified",
+        "operationId": "subscribeBlockEvents",
+        "parameters": [
+          {
+            "type": "array",
+            "items": {
+              "enum": [
+                "block_archived",
+                "block_verified"
+              ],
               "type": "string"
+            },
+            "collectionFormat": "csv",
+            "description": "Types of the events to send, all if not set",
+            "name": "types",
+            "in": "query"
+          },
+          {
+            "minimum": 0,
+            "type": "integer",
+            "format": "int64",
+            "description": "Id of the last event received, the subscription resumes after it",
+            "name": "after",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Id of the last event received, sent by a reconnecting EventSource, it takes precedence over after",
+            "name": "Last-Event-ID",
+            "in": "header"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/BlockEvent"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "the server has reached its maximum subscribers, retry later",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           }
-        },
-        "tx_hash": {
-          "type": "string"
-        },
-        "tx_index": {
-          "type": "integer",
-          "format": "int64",
-          "x-omitempty": true
         }
       }
-    }
-  }
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "API for handling blob query in the Blob Hub.",
-    "title": "Blob Hub Service API",
-    "version": "1.0.0"
-  },
-  "host": "blob-hub",
-  "paths": {
-    "/": {
-      "post": {
+    },
+    "/blobhub/v1/txs/{tx_hash}/blobs": {
+      "get": {
         "produces": [
           "application/json"
         ],
         "tags": [
           "blob"
         ],
-        "summary": "Get BSC blob sidecars by block num",
-        "operationId": "getBSCBlobSidecarsByBlockNum",
+        "summary": "Get blob sidecars by tx hash",
+        "operationId": "getBlobsByTxHash",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/RPCRequest"
-            }
+            "minLength": 1,
+            "type": "string",
+            "description": "Hash of the blob tx with 0x prefix",
+            "name": "tx_hash",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
             "description": "successful operation",
             "schema": {
-              "$ref": "#/definitions/RPCResponse"
+              "$ref": "#/definitions/GetBlobsByTxHashResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "blob not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "429": {
+            "description": "the archive storage is rate limiting the service, retry after the Retry-After header",
+            "schema": {
+              "$ref": "#/definitions/Error"
             }
           },
           "500": {
@@ -381,6 +1527,12 @@ func init() {
             "schema": {
               "$ref": "#/definitions/Error"
             }
+          },
+          "503": {
+            "description": "a dependency is temporarily unavailable, retry later",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
           }
         }
       }
@@ -388,7 +1540,8 @@ func init() {
     "/eth/v1/beacon/blob_sidecars/{block_id}": {
       "get": {
         "produces": [
-          "application/json"
+          "application/json",
+          "application/octet-stream"
         ],
         "tags": [
           "blob"
@@ -399,7 +1552,7 @@ func init() {
           {
             "minLength": 1,
             "type": "string",
-            "description": "Block identifier. Can be one of: 'head' (canonical head in node's view), 'genesis', 'finalized', \u003cslot\u003e, \u003chex encoded blockRoot with 0x prefix\u003e",
+            "description": "Block identifier. Can be one of: 'head' (the latest archived block), 'genesis' (the first archived block), 'finalized' (the latest verified block), \u003cslot\u003e, \u003chex encoded blockRoot with 0x prefix\u003e",
             "name": "block_id",
             "in": "path",
             "required": true
@@ -416,7 +1569,7 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "successful operation",
+            "description": "successful operation, the sidecars are SSZ encoded when application/octet-stream is accepted",
             "schema": {
               "$ref": "#/definitions/GetBlobSideCarsResponse"
             }
@@ -424,19 +1577,163 @@ func init() {
           "400": {
             "description": "Bad Request",
             "schema": {
-              "$ref": "#/definitions/Error"
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "blob not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "406": {
+            "description": "SSZ encoding is not acceptable on the chain",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "429": {
+            "description": "the archive storage is rate limiting the service, retry after the Retry-After header",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "a dependency is temporarily unavailable, retry later",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/eth/v1/beacon/genesis": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "beacon"
+        ],
+        "summary": "Get the genesis of the configured network",
+        "operationId": "getGenesis",
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/GetGenesisResponse"
+            }
+          },
+          "404": {
+            "description": "network not configured",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/eth/v1/beacon/headers/{block_id}": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "beacon"
+        ],
+        "summary": "Get the header of an archived block",
+        "operationId": "getBlockHeader",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "Block identifier. Can be one of: 'head' (the latest archived block), 'genesis' (the first archived block), 'finalized' (the latest verified block), \u003cslot\u003e, \u003chex encoded blockRoot with 0x prefix\u003e",
+            "name": "block_id",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/GetBlockHeaderResponse"
+            }
+          },
+          "400": {
+            "description": "Bad Request",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "404": {
+            "description": "block not found",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "500": {
+            "description": "internal server error",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          },
+          "503": {
+            "description": "a dependency is temporarily unavailable, retry later",
+            "schema": {
+              "$ref": "#/definitions/Error"
+            }
+          }
+        }
+      }
+    },
+    "/eth/v1/config/spec": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "beacon"
+        ],
+        "summary": "Get the spec values of the configured network",
+        "operationId": "getSpec",
+        "responses": {
+          "200": {
+            "description": "successful operation",
+            "schema": {
+              "$ref": "#/definitions/GetSpecResponse"
             }
           },
           "404": {
-            "description": "blob not found",
+            "description": "network not configured",
             "schema": {
               "$ref": "#/definitions/Error"
             }
-          },
-          "500": {
-            "description": "internal server error",
+          }
+        }
+      }
+    },
+    "/eth/v1/node/version": {
+      "get": {
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "beacon"
+        ],
+        "summary": "Get the version of the blob hub",
+        "operationId": "getNodeVersion",
+        "responses": {
+          "200": {
+            "description": "successful operation",
             "schema": {
-              "$ref": "#/definitions/Error"
+              "$ref": "#/definitions/GetNodeVersionResponse"
             }
           }
         }
@@ -487,6 +1784,109 @@ func init() {
         }
       }
     },
+    "BlobMeta": {
+      "type": "object",
+      "properties": {
+        "from": {
+          "description": "sender of the blob tx, empty for the blobs archived before it was recorded",
+          "type": "string"
+        },
+        "index": {
+          "type": "string",
+          "example": "0"
+        },
+        "kzg_commitment": {
+          "type": "string"
+        },
+        "slot": {
+          "description": "slot(ETH) or block number(BSC) of the blob",
+          "type": "string",
+          "example": "8783262"
+        },
+        "to": {
+          "type": "string"
+        },
+        "tx_hash": {
+          "type": "string"
+        },
+        "tx_index": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "versioned_hash": {
+          "type": "string"
+        }
+      }
+    },
+    "BlockEvent": {
+      "type": "object",
+      "properties": {
+        "blob_count": {
+          "type": "integer",
+          "format": "int64",
+          "x-omitempty": false
+        },
+        "blobs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BlobMeta"
+          }
+        },
+        "block_hash": {
+          "description": "hash of the execution(ETH) or BSC block, empty for the blocks archived before it was recorded",
+          "type": "string"
+        },
+        "block_id": {
+          "description": "slot(ETH) or block number(BSC) of the block",
+          "type": "string",
+          "example": "8783262"
+        },
+        "bundle_name": {
+          "type": "string"
+        },
+        "el_block_height": {
+          "description": "height of the execution block, empty on BSC",
+          "type": "string"
+        },
+        "id": {
+          "description": "id of the event, a subscription resumes after it",
+          "type": "integer",
+          "format": "int64"
+        },
+        "root": {
+          "description": "root of the beacon block, empty on BSC and for the slots without a block",
+          "type": "string"
+        },
+        "time": {
+          "description": "unix time the event is recorded at",
+          "type": "integer",
+          "format": "int64"
+        },
+        "type": {
+          "type": "string",
+          "enum": [
+            "block_archived",
+            "block_verified"
+          ]
+        }
+      }
+    },
+    "BlockHeader": {
+      "type": "object",
+      "properties": {
+        "canonical": {
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "header": {
+          "$ref": "#/definitions/SignedBeaconBlockHeader"
+        },
+        "root": {
+          "type": "string"
+        }
+      }
+    },
     "Error": {
       "type": "object",
       "properties": {
@@ -502,12 +1902,52 @@ func init() {
           "type": "string",
           "x-omitempty": false,
           "example": "Bad request/Internal server error"
+        },
+        "reason": {
+          "description": "Machine-readable reason of the error",
+          "type": "string",
+          "enum": [
+            "INVALID_ARGUMENT",
+            "NOT_ACCEPTABLE",
+            "NOT_SUPPORTED",
+            "BLOCK_NOT_FOUND",
+            "NOT_ARCHIVED_YET",
+            "BLOB_NOT_FOUND",
+            "BUNDLE_NOT_FOUND",
+            "RATE_LIMITED",
+            "UNAVAILABLE",
+            "INTERNAL"
+          ],
+          "example": "BLOCK_NOT_FOUND"
+        }
+      }
+    },
+    "Genesis": {
+      "type": "object",
+      "properties": {
+        "genesis_fork_version": {
+          "type": "string",
+          "example": "0x00000000"
+        },
+        "genesis_time": {
+          "type": "string",
+          "example": "1606824023"
+        },
+        "genesis_validators_root": {
+          "type": "string",
+          "example": "0x4b363db94e286120d76eb905340fdd4e54bfe9f06bf33ff6cf5ad27f511bfe95"
         }
       }
     },
     "GetBlobSideCarsResponse": {
       "type": "object",
       "properties": {
+        "archive_lag": {
+          "description": "number of slots the archive lags the chain head by, only set when the block is identified by 'head', 'finalized' or 'genesis' and the network is configured",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
         "code": {
           "description": "status code",
           "type": "integer",
@@ -527,6 +1967,109 @@ func init() {
         }
       }
     },
+    "GetBlobsByAddressResponse": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/BlobMeta"
+          }
+        },
+        "has_more": {
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "page": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "page_size": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "GetBlobsByTxHashResponse": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VersionedBlob"
+          }
+        }
+      }
+    },
+    "GetBlobsByVersionedHashesResponse": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/VersionedBlob"
+          }
+        }
+      }
+    },
+    "GetBlockHeaderResponse": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/BlockHeader"
+        },
+        "execution_optimistic": {
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "finalized": {
+          "type": "boolean",
+          "x-omitempty": false
+        }
+      }
+    },
+    "GetGenesisResponse": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "$ref": "#/definitions/Genesis"
+        }
+      }
+    },
+    "GetNodeVersionResponse": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "type": "object",
+          "properties": {
+            "version": {
+              "type": "string",
+              "example": "BlobHub/v1.0.0-5e0a2d4/linux-amd64"
+            }
+          }
+        }
+      }
+    },
+    "GetNodeVersionResponseData": {
+      "type": "object",
+      "properties": {
+        "version": {
+          "type": "string",
+          "example": "BlobHub/v1.0.0-5e0a2d4/linux-amd64"
+        }
+      }
+    },
+    "GetSpecResponse": {
+      "type": "object",
+      "properties": {
+        "data": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        }
+      }
+    },
     "RPCError": {
       "type": "object",
       "properties": {
@@ -549,7 +2092,7 @@ func init() {
       "type": "object",
       "properties": {
         "id": {
-          "type": "integer",
+          "description": "number or string identifier of the request",
           "example": 1
         },
         "jsonrpc": {
@@ -562,9 +2105,7 @@ func init() {
         },
         "params": {
           "type": "array",
-          "items": {
-            "type": "string"
-          },
+          "items": {},
           "example": [
             "0x1",
             true
@@ -579,7 +2120,8 @@ func init() {
           "$ref": "#/definitions/RPCError"
         },
         "id": {
-          "type": "integer",
+          "description": "identifier of the request",
+          "x-omitempty": false,
           "example": 1
         },
         "jsonrpc": {
@@ -587,10 +2129,8 @@ func init() {
           "example": "2.0"
         },
         "result": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/BSCBlobTxSidecar"
-          }
+          "description": "array of BSCBlobTxSidecar for eth_getBlobSidecars, a BSCBlobTxSidecar for eth_getBlobSidecarByTxHash, or null if not found",
+          "x-omitempty": false
         }
       }
     },
@@ -652,6 +2192,11 @@ func init() {
           "type": "integer",
           "format": "int64",
           "x-omitempty": true
+        },
+        "unarchived": {
+          "description": "the blob is not archived yet, it is served from the chain after its KZG proof is verified",
+          "type": "boolean",
+          "x-omitempty": true
         }
       }
     },
@@ -702,6 +2247,97 @@ func init() {
           "type": "string"
         }
       }
+    },
+    "SignedBeaconBlockHeader": {
+      "type": "object",
+      "properties": {
+        "message": {
+          "type": "object",
+          "properties": {
+            "body_root": {
+              "type": "string"
+            },
+            "parent_root": {
+              "type": "string"
+            },
+            "proposer_index": {
+              "type": "string"
+            },
+            "slot": {
+              "type": "string"
+            },
+            "state_root": {
+              "type": "string"
+            }
+          }
+        },
+        "signature": {
+          "type": "string"
+        }
+      }
+    },
+    "SignedBeaconBlockHeaderMessage": {
+      "type": "object",
+      "properties": {
+        "body_root": {
+          "type": "string"
+        },
+        "parent_root": {
+          "type": "string"
+        },
+        "proposer_index": {
+          "type": "string"
+        },
+        "slot": {
+          "type": "string"
+        },
+        "state_root": {
+          "type": "string"
+        }
+      }
+    },
+    "StreamedBlob": {
+      "type": "object",
+      "properties": {
+        "cursor": {
+          "description": "cursor to resume the stream after the blob",
+          "type": "string",
+          "example": "8783262:1"
+        },
+        "sidecar": {
+          "$ref": "#/definitions/Sidecar"
+        },
+        "slot": {
+          "description": "slot(ETH) or block number(BSC) of the blob",
+          "type": "string",
+          "example": "8783262"
+        },
+        "tx_hash": {
+          "type": "string"
+        },
+        "versioned_hash": {
+          "type": "string"
+        }
+      }
+    },
+    "VersionedBlob": {
+      "type": "object",
+      "properties": {
+        "sidecar": {
+          "$ref": "#/definitions/Sidecar"
+        },
+        "slot": {
+          "description": "slot(ETH) or block number(BSC) of the blob",
+          "type": "string",
+          "example": "8783262"
+        },
+        "tx_hash": {
+          "type": "string"
+        },
+        "versioned_hash": {
+          "type": "string"
+        }
+      }
     }
   }
 }`))
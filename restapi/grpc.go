@@ -0,0 +1,241 @@
+package restapi
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	grpcruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/bnb-chain/blob-hub/client"
+	"github.com/bnb-chain/blob-hub/config"
+	blobproto "github.com/bnb-chain/blob-hub/proto"
+)
+
+const (
+	// gatewayMaxMsgSize bounds the responses the gateway proxies, the sidecars of a block are up to a few MB
+	gatewayMaxMsgSize = 20 * 1024 * 1024
+	// grpcShutdownTimeout is how long the in-flight calls are waited for before they are cancelled
+	grpcShutdownTimeout = 10 * time.Second
+)
+
+// grpcServer is the gRPC server along with its health service and REST gateway
+type grpcServer struct {
+	server  *grpc.Server
+	health  *health.Server
+	gateway *http.Server // nil if the gateway is off
+}
+
+// startGRPCServer listens on the gRPC and gateway addresses and serves them in the background
+func startGRPCServer(cfg *config.GRPCConfig) (*grpcServer, error) {
+	opts := []grpc.ServerOption{grpc.StatsHandler(otelgrpc.NewServerHandler())}
+	var tlsConfig *tls.Config
+	if cfg.TLSEnabled() {
+		var err error
+		tlsConfig, err = serverTLSConfig(&cfg.TLSConfig)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+	}
+	s := &grpcServer{
+		server: grpc.NewServer(opts...),
+		health: health.NewServer(),
+	}
+	blobproto.RegisterBlobServiceServer(s.server, &client.BlobServer{})
+	healthpb.RegisterHealthServer(s.server, s.health)
+	s.health.SetServingStatus(blobproto.BlobService_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	if cfg.Reflection {
+		reflection.Register(s.server)
+	}
+
+	lis, err := net.Listen("tcp", cfg.GetAddress())
+	if err != nil {
+		return nil, fmt.Errorf("failed to listen on %s, err=%s", cfg.GetAddress(), err.Error())
+	}
+	go func() {
+		if err := s.server.Serve(lis); err != nil {
+			log.Println("gRPC server stopped:", err)
+		}
+	}()
+	log.Printf("Serving gRPC on %s, TLS %t, mTLS %t", lis.Addr(), cfg.TLSEnabled(), cfg.TLSConfig.ClientCAFile != "")
+
+	if cfg.GatewayAddress == "" {
+		return s, nil
+	}
+	if err = s.startGateway(cfg, loopbackAddress(lis.Addr().String()), tlsConfig); err != nil {
+		s.server.Stop()
+		return nil, err
+	}
+	return s, nil
+}
+
+// startGateway serves the REST gateway, which proxies the requests to the gRPC listener at its loopback address
+func (s *grpcServer) startGateway(cfg *config.GRPCConfig, grpcAddr string, serverTLSConfig *tls.Config) error {
+	creds := insecure.NewCredentials()
+	if serverTLSConfig != nil {
+		tlsConfig, err := gatewayTLSConfig(&cfg.TLSConfig, serverTLSConfig, grpcAddr)
+		if err != nil {
+			return err
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+	conn, err := grpc.NewClient(
+		grpcAddr,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(gatewayMaxMsgSize), grpc.MaxCallSendMsgSize(gatewayMaxMsgSize)),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to dial the gRPC server, err=%s", err.Error())
+	}
+	gwmux := grpcruntime.NewServeMux()
+	if err = blobproto.RegisterBlobServiceHandler(context.Background(), gwmux, conn); err != nil {
+		return fmt.Errorf("failed to register the gRPC gateway, err=%s", err.Error())
+	}
+	lis, err := net.Listen("tcp", cfg.GatewayAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s, err=%s", cfg.GatewayAddress, err.Error())
+	}
+	s.gateway = &http.Server{
+		Handler:           otelhttp.NewHandler(gwmux, "grpc-gateway"),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	s.gateway.RegisterOnShutdown(func() { _ = conn.Close() })
+	go func() {
+		if err := s.gateway.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Println("gRPC gateway stopped:", err)
+		}
+	}()
+	log.Printf("Serving gRPC-Gateway on %s", lis.Addr())
+	return nil
+}
+
+// drain reports the server as not serving, so that the health checking clients move away before it stops
+func (s *grpcServer) drain() {
+	s.health.Shutdown()
+}
+
+// stop stops the gateway, and the gRPC server once its in-flight calls finish or grpcShutdownTimeout passes
+func (s *grpcServer) stop() {
+	s.health.Shutdown()
+	ctx, cancel := context.WithTimeout(context.Background(), grpcShutdownTimeout)
+	defer cancel()
+	if s.gateway != nil {
+		if err := s.gateway.Shutdown(ctx); err != nil {
+			log.Println("Failed to shutdown the gRPC gateway:", err)
+		}
+	}
+	stopped := make(chan struct{})
+	go func() {
+		s.server.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.server.Stop()
+	}
+}
+
+func serverTLSConfig(cfg *config.GRPCTLSConfig) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the gRPC certificate, err=%s", err.Error())
+	}
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if cfg.ClientCAFile != "" {
+		pool, err := loadCertPool(cfg.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ClientCAs = pool
+		tlsConfig.ClientAuth = tls.RequireAndVerifyClientCert
+	}
+	return tlsConfig, nil
+}
+
+// gatewayTLSConfig lets the gateway trust the certificate of the server it runs along, reached at grpcAddr, and
+// present the gateway certificate under mTLS
+func gatewayTLSConfig(cfg *config.GRPCTLSConfig, serverTLSConfig *tls.Config, grpcAddr string) (*tls.Config, error) {
+	leaf, err := x509.ParseCertificate(serverTLSConfig.Certificates[0].Certificate[0])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the gRPC certificate, err=%s", err.Error())
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(leaf)
+	serverName, err := gatewayServerName(leaf, grpcAddr)
+	if err != nil {
+		return nil, err
+	}
+	tlsConfig := &tls.Config{
+		RootCAs:    roots,
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if serverTLSConfig.ClientAuth == tls.RequireAndVerifyClientCert {
+		cert, err := tls.LoadX509KeyPair(cfg.GatewayCertFile, cfg.GatewayKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load the gRPC gateway certificate, err=%s", err.Error())
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	return tlsConfig, nil
+}
+
+// gatewayServerName returns the name the server certificate is verified against, the host the gateway dials if the
+// certificate names it, else its first SAN. The common name is not verified by Go.
+func gatewayServerName(leaf *x509.Certificate, grpcAddr string) (string, error) {
+	if host, _, err := net.SplitHostPort(grpcAddr); err == nil && leaf.VerifyHostname(host) == nil {
+		return host, nil
+	}
+	if len(leaf.DNSNames) > 0 {
+		return leaf.DNSNames[0], nil
+	}
+	if len(leaf.IPAddresses) > 0 {
+		return leaf.IPAddresses[0].String(), nil
+	}
+	return "", errors.New("the gRPC certificate has no DNS or IP subject alternative name")
+}
+
+func loadCertPool(file string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(file)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s, err=%s", file, err.Error())
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("no certificate found in %s", file)
+	}
+	return pool, nil
+}
+
+// loopbackAddress returns the address to reach a listener of the process, which might listen on all interfaces
+func loopbackAddress(addr string) string {
+	host, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return addr
+	}
+	if ip := net.ParseIP(host); host == "" || (ip != nil && ip.IsUnspecified()) {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, port)
+}
@@ -0,0 +1,193 @@
+package restapi
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+
+	"github.com/bnb-chain/blob-hub/config"
+)
+
+// testCert is a certificate along with its key, written to PEM files
+type testCert struct {
+	cert     *x509.Certificate
+	key      *ecdsa.PrivateKey
+	certFile string
+	keyFile  string
+}
+
+// newTestCert issues a certificate from the template, self-signed if issuer is nil
+func newTestCert(t *testing.T, dir, name string, template *x509.Certificate, issuer *testCert) *testCert {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template.SerialNumber = big.NewInt(time.Now().UnixNano())
+	template.Subject = pkix.Name{CommonName: name}
+	template.NotBefore = time.Now().Add(-time.Hour)
+	template.NotAfter = time.Now().Add(time.Hour)
+	parent, signer := template, key
+	if issuer != nil {
+		parent, signer = issuer.cert, issuer.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &testCert{cert: cert, key: key, certFile: filepath.Join(dir, name+".pem"), keyFile: filepath.Join(dir, name+".key")}
+	if err = os.WriteFile(c.certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err = os.WriteFile(c.keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func newTestCA(t *testing.T, dir, name string) *testCert {
+	return newTestCert(t, dir, name, &x509.Certificate{IsCA: true, BasicConstraintsValid: true, KeyUsage: x509.KeyUsageCertSign}, nil)
+}
+
+func TestGatewayServerName(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name     string
+		template *x509.Certificate
+		grpcAddr string
+		want     string
+		wantErr  bool
+	}{
+		{name: "dialed host", template: &x509.Certificate{DNSNames: []string{"blob-hub.internal", "localhost"}}, grpcAddr: "localhost:9000", want: "localhost"},
+		{name: "dialed IP", template: &x509.Certificate{IPAddresses: []net.IP{net.ParseIP("10.0.0.1"), net.ParseIP("127.0.0.1")}}, grpcAddr: "127.0.0.1:9000", want: "127.0.0.1"},
+		{name: "first DNS SAN", template: &x509.Certificate{DNSNames: []string{"blob-hub.internal"}}, grpcAddr: "localhost:9000", want: "blob-hub.internal"},
+		{name: "IP SAN only", template: &x509.Certificate{IPAddresses: []net.IP{net.ParseIP("127.0.0.1")}}, grpcAddr: "localhost:9000", want: "127.0.0.1"},
+		{name: "common name only", template: &x509.Certificate{}, grpcAddr: "localhost:9000", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cert := newTestCert(t, dir, "server", tt.template, nil)
+			got, err := gatewayServerName(cert.cert, tt.grpcAddr)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("gatewayServerName() = %s, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("gatewayServerName() failed, err=%s", err.Error())
+			}
+			if got != tt.want {
+				t.Errorf("gatewayServerName() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestGatewayTLSConfig checks the gateway completes the handshake with the gRPC listener, the server certificate
+// being for server auth only and naming a loopback IP only
+func TestGatewayTLSConfig(t *testing.T) {
+	dir := t.TempDir()
+	serverCA, clientCA, otherCA := newTestCA(t, dir, "server-ca"), newTestCA(t, dir, "client-ca"), newTestCA(t, dir, "other-ca")
+	server := newTestCert(t, dir, "server", &x509.Certificate{
+		IPAddresses: []net.IP{net.ParseIP("127.0.0.1")},
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}, serverCA)
+	clientTemplate := func() *x509.Certificate {
+		return &x509.Certificate{ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}}
+	}
+	gateway := newTestCert(t, dir, "gateway", clientTemplate(), clientCA)
+	untrusted := newTestCert(t, dir, "untrusted", clientTemplate(), otherCA)
+
+	tests := []struct {
+		name    string
+		cfg     config.GRPCTLSConfig
+		wantErr bool
+	}{
+		{name: "TLS", cfg: config.GRPCTLSConfig{CertFile: server.certFile, KeyFile: server.keyFile}},
+		{name: "mTLS", cfg: config.GRPCTLSConfig{CertFile: server.certFile, KeyFile: server.keyFile, ClientCAFile: clientCA.certFile,
+			GatewayCertFile: gateway.certFile, GatewayKeyFile: gateway.keyFile}},
+		{name: "mTLS with a gateway certificate of another CA", cfg: config.GRPCTLSConfig{CertFile: server.certFile, KeyFile: server.keyFile,
+			ClientCAFile: clientCA.certFile, GatewayCertFile: untrusted.certFile, GatewayKeyFile: untrusted.keyFile}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			serverTLS, err := serverTLSConfig(&tt.cfg)
+			if err != nil {
+				t.Fatal(err)
+			}
+			srv := grpc.NewServer(grpc.Creds(credentials.NewTLS(serverTLS)))
+			healthpb.RegisterHealthServer(srv, health.NewServer())
+			lis, err := net.Listen("tcp", "127.0.0.1:0")
+			if err != nil {
+				t.Fatal(err)
+			}
+			go func() { _ = srv.Serve(lis) }()
+			defer srv.Stop()
+
+			// the gateway dials the listener the way it does when the server listens on all interfaces
+			_, port, _ := net.SplitHostPort(lis.Addr().String())
+			grpcAddr := loopbackAddress(net.JoinHostPort("0.0.0.0", port))
+			gatewayTLS, err := gatewayTLSConfig(&tt.cfg, serverTLS, grpcAddr)
+			if err != nil {
+				t.Fatalf("gatewayTLSConfig() failed, err=%s", err.Error())
+			}
+			conn, err := grpc.NewClient(grpcAddr, grpc.WithTransportCredentials(credentials.NewTLS(gatewayTLS)))
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer conn.Close()
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("Check() succeeded, want the handshake to fail")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Check() failed, err=%s", err.Error())
+			}
+		})
+	}
+}
+
+func TestGatewayTLSConfigMissingCertificate(t *testing.T) {
+	serverTLS := &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert}
+	dir := t.TempDir()
+	server := newTestCert(t, dir, "server", &x509.Certificate{IPAddresses: []net.IP{net.ParseIP("127.0.0.1")}}, nil)
+	pair, err := tls.LoadX509KeyPair(server.certFile, server.keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serverTLS.Certificates = []tls.Certificate{pair}
+	cfg := &config.GRPCTLSConfig{GatewayCertFile: filepath.Join(dir, "missing.pem"), GatewayKeyFile: filepath.Join(dir, "missing.key")}
+	if _, err = gatewayTLSConfig(cfg, serverTLS, "localhost:9000"); err == nil {
+		t.Fatal("gatewayTLSConfig() succeeded without the gateway certificate")
+	}
+}
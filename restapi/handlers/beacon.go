@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/bnb-chain/blob-hub/models"
+	"github.com/bnb-chain/blob-hub/restapi/operations/beacon"
+	"github.com/bnb-chain/blob-hub/service"
+	"github.com/bnb-chain/blob-hub/types"
+	"github.com/bnb-chain/blob-hub/util"
+	"github.com/bnb-chain/blob-hub/version"
+)
+
+func HandleGetGenesis() func(params beacon.GetGenesisParams) middleware.Responder {
+	return func(params beacon.GetGenesisParams) middleware.Responder {
+		genesis, err := service.BeaconSvc.GetGenesis()
+		if err != nil {
+			return beacon.NewGetGenesisNotFound().WithPayload(service.NotFoundWithError(err))
+		}
+		return beacon.NewGetGenesisOK().WithPayload(&models.GetGenesisResponse{Data: genesis})
+	}
+}
+
+func HandleGetSpec() func(params beacon.GetSpecParams) middleware.Responder {
+	return func(params beacon.GetSpecParams) middleware.Responder {
+		spec, err := service.BeaconSvc.GetSpec()
+		if err != nil {
+			return beacon.NewGetSpecNotFound().WithPayload(service.NotFoundWithError(err))
+		}
+		return beacon.NewGetSpecOK().WithPayload(&models.GetSpecResponse{Data: spec})
+	}
+}
+
+func HandleGetNodeVersion() func(params beacon.GetNodeVersionParams) middleware.Responder {
+	return func(params beacon.GetNodeVersionParams) middleware.Responder {
+		return beacon.NewGetNodeVersionOK().WithPayload(&models.GetNodeVersionResponse{
+			Data: &models.GetNodeVersionResponseData{Version: version.NodeVersion()},
+		})
+	}
+}
+
+func HandleGetBlockHeader() func(params beacon.GetBlockHeaderParams) middleware.Responder {
+	return func(params beacon.GetBlockHeaderParams) middleware.Responder {
+		blockID := params.BlockID
+		var (
+			header *models.BlockHeader
+			err    error
+		)
+		switch blockID {
+		case service.BlockIDHead, service.BlockIDFinalized, service.BlockIDGenesis:
+			var slot uint64
+			slot, _, err = service.BlobSvc.ResolveBlockIdentifier(params.HTTPRequest.Context(), blockID)
+			if err == nil {
+				header, err = service.BeaconSvc.GetBlockHeaderBySlot(params.HTTPRequest.Context(), slot)
+			}
+		default:
+			root, decodeErr := hexutil.Decode(blockID)
+			if decodeErr == nil {
+				if len(root) != types.RootLength {
+					return beacon.NewGetBlockHeaderBadRequest().WithPayload(service.BadRequestWithError(fmt.Errorf("invalid block root of length %d", len(root))))
+				}
+				header, err = service.BeaconSvc.GetBlockHeaderByRoot(params.HTTPRequest.Context(), hex.EncodeToString(root))
+			} else {
+				slot, parseErr := util.StringToUint64(blockID)
+				if parseErr != nil {
+					return beacon.NewGetBlockHeaderBadRequest().WithPayload(service.BadRequestWithError(parseErr))
+				}
+				header, err = service.BeaconSvc.GetBlockHeaderBySlot(params.HTTPRequest.Context(), slot)
+			}
+		}
+		if err != nil {
+			return errorResponder(err, "failed to get block header of block_id %s", blockID)
+		}
+		return beacon.NewGetBlockHeaderOK().WithPayload(&models.GetBlockHeaderResponse{
+			ExecutionOptimistic: false,
+			Finalized:           true, // only finalized blocks are archived
+			Data:                header,
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/bnb-chain/blob-hub/config"
+	"github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/restapi/operations/beacon"
+	"github.com/bnb-chain/blob-hub/service"
+)
+
+// readFixture reads a response of testdata/beacon. They are in the format a mainnet beacon node answers the endpoints the
+// rollup nodes call, with the genesis and spec values of mainnet and the header of a sample block, and the api server is
+// expected to answer the same.
+func readFixture(t *testing.T, name string) map[string]interface{} {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "beacon", name))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fixture map[string]interface{}
+	if err = json.Unmarshal(data, &fixture); err != nil {
+		t.Fatal(err)
+	}
+	return fixture
+}
+
+func serve(t *testing.T, responder middleware.Responder) map[string]interface{} {
+	t.Helper()
+	recorder := httptest.NewRecorder()
+	responder.WriteResponse(recorder, runtime.JSONProducer())
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status = %d, body = %s", recorder.Code, recorder.Body.String())
+	}
+	var body map[string]interface{}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	return body
+}
+
+func setUpBeaconService(t *testing.T) db.BlobDao {
+	t.Helper()
+	gormDB, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "blob-hub.db")), &gorm.Config{
+		Logger:         logger.Default.LogMode(logger.Silent),
+		TranslateError: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = db.MigrateUp(gormDB, db.LatestSchemaVersion()); err != nil {
+		t.Fatal(err)
+	}
+	blobDB := db.NewBlobSvcDB(gormDB)
+	prevBeaconSvc := service.BeaconSvc
+	t.Cleanup(func() { service.BeaconSvc = prevBeaconSvc })
+	service.BeaconSvc = service.NewBeaconService(blobDB, &config.ServerConfig{Chain: config.ETH, Network: "mainnet"})
+	return blobDB
+}
+
+func TestBeaconGenesisConformance(t *testing.T) {
+	setUpBeaconService(t)
+	got := serve(t, HandleGetGenesis()(beacon.NewGetGenesisParams()))
+	if want := readFixture(t, "genesis.json"); !reflect.DeepEqual(got, want) {
+		t.Errorf("genesis = %v, want %v", got, want)
+	}
+}
+
+func TestBeaconSpecConformance(t *testing.T) {
+	setUpBeaconService(t)
+	got := serve(t, HandleGetSpec()(beacon.NewGetSpecParams()))["data"].(map[string]interface{})
+	want := readFixture(t, "spec.json")["data"].(map[string]interface{})
+	// the beacon node serves the whole config, the api server the subset the rollup nodes read
+	for key, value := range got {
+		if want[key] != value {
+			t.Errorf("spec %s = %v, want %v", key, value, want[key])
+		}
+	}
+	for _, key := range []string{
+		"CONFIG_NAME", "PRESET_BASE", "GENESIS_FORK_VERSION", "SECONDS_PER_SLOT", "SLOTS_PER_EPOCH",
+		"DENEB_FORK_EPOCH", "DENEB_FORK_VERSION", "ELECTRA_FORK_EPOCH", "ELECTRA_FORK_VERSION",
+		"FULU_FORK_EPOCH", "FULU_FORK_VERSION", "MAX_BLOBS_PER_BLOCK", "MAX_BLOBS_PER_BLOCK_ELECTRA",
+	} {
+		if _, ok := got[key]; !ok {
+			t.Errorf("spec %s is not served", key)
+		}
+	}
+}
+
+func TestBeaconNodeVersionConformance(t *testing.T) {
+	got := serve(t, HandleGetNodeVersion()(beacon.NewGetNodeVersionParams()))
+	want := readFixture(t, "node_version.json")
+	gotVersion, _ := got["data"].(map[string]interface{})["version"].(string)
+	wantVersion := want["data"].(map[string]interface{})["version"].(string)
+	// {client}/{version}/{platform}
+	if gotParts, wantParts := strings.Split(gotVersion, "/"), strings.Split(wantVersion, "/"); len(gotParts) != len(wantParts) {
+		t.Errorf("version = %s, want the format of %s", gotVersion, wantVersion)
+	}
+	if len(got) != len(want) || len(got["data"].(map[string]interface{})) != len(want["data"].(map[string]interface{})) {
+		t.Errorf("node version = %v, want the fields of %v", got, want)
+	}
+}
+
+func TestBeaconHeaderConformance(t *testing.T) {
+	blobDB := setUpBeaconService(t)
+	want := readFixture(t, "header.json")
+	data := want["data"].(map[string]interface{})
+	header := data["header"].(map[string]interface{})
+	message := header["message"].(map[string]interface{})
+	// the syncer saves the block without the 0x prefix
+	block := &db.Block{
+		Root:          strings.TrimPrefix(data["root"].(string), "0x"),
+		ParentRoot:    strings.TrimPrefix(message["parent_root"].(string), "0x"),
+		StateRoot:     strings.TrimPrefix(message["state_root"].(string), "0x"),
+		BodyRoot:      strings.TrimPrefix(message["body_root"].(string), "0x"),
+		ProposerIndex: 452467,
+		Signature:     strings.TrimPrefix(header["signature"].(string), "0x"),
+		Slot:          8783262,
+		BundleName:    "blobs_s8783232_e8783263",
+	}
+	if err := blobDB.SaveBlockAndBlob(block, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, blockID := range []string{data["root"].(string), message["slot"].(string)} {
+		params := beacon.NewGetBlockHeaderParams()
+		params.HTTPRequest = httptest.NewRequest(http.MethodGet, "/eth/v1/beacon/headers/"+blockID, nil)
+		params.BlockID = blockID
+		if got := serve(t, HandleGetBlockHeader()(params)); !reflect.DeepEqual(got, want) {
+			t.Errorf("header of %s = %v, want %v", blockID, got, want)
+		}
+	}
+}
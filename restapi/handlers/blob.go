@@ -1,12 +1,23 @@
 package handlers
 
 import (
+	"context"
 	"encoding/hex"
+	"errors"
 	"fmt"
+	"math"
+	"net/http"
+	"strconv"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/prysmaticlabs/prysm/v5/api"
 
+	"github.com/bnb-chain/blob-hub/config"
+	"github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/logging"
 	"github.com/bnb-chain/blob-hub/models"
 	"github.com/bnb-chain/blob-hub/restapi/operations/blob"
 	"github.com/bnb-chain/blob-hub/service"
@@ -16,130 +27,198 @@ import (
 
 func HandleGetBlobSidecars() func(params blob.GetBlobSidecarsByBlockNumParams) middleware.Responder {
 	return func(params blob.GetBlobSidecarsByBlockNumParams) middleware.Responder {
+		responder := getBlobSidecars(params)
+		if _, ok := responder.(*sszResponder); ok {
+			return responder
+		}
+		// errors and JSON sidecars are always written as JSON, whichever content type was negotiated
+		return jsonResponder(responder)
+	}
+}
 
-		blockID := params.BlockID
-		indices := params.Indices
-		var root []byte
-		switch blockID {
-		case "genesis", "finalized":
-			return blob.NewGetBlobSidecarsByBlockNumBadRequest().WithPayload(service.BadRequestWithError(fmt.Errorf("block identifier not supported, only <slot> and <block root>")))
-		default:
-			var (
-				err      error
-				sidecars []*models.Sidecar
-			)
-
-			indicesInx := make([]int64, 0)
-			for _, idx := range indices {
-				i, err := util.StringToInt64(idx)
-				if err != nil {
-					return blob.NewGetBlobSidecarsByBlockNumBadRequest().WithPayload(service.BadRequestWithError(err))
-				}
-				indicesInx = append(indicesInx, i)
-			}
+// jsonResponder writes the response as JSON whichever content type was negotiated
+func jsonResponder(responder middleware.Responder) middleware.Responder {
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+		rw.Header().Set(runtime.HeaderContentType, runtime.JSONMime)
+		responder.WriteResponse(rw, runtime.JSONProducer())
+	})
+}
 
-			root, err = hexutil.Decode(blockID)
-			if err == nil {
-				if len(root) != types.RootLength {
-					return blob.NewGetBlobSidecarsByBlockNumBadRequest().WithPayload(service.BadRequestWithError(fmt.Errorf("invalid block root of length %d", len(root))))
-				}
-				sidecars, err = service.BlobSvc.GetBlobSidecarsByRoot(hex.EncodeToString(root), indicesInx)
-				if err != nil {
-					return blob.NewGetBlobSidecarsByBlockNumInternalServerError().WithPayload(service.InternalError())
-				}
-			} else {
-				slot, err := util.StringToUint64(blockID)
-				if err != nil {
-					return blob.NewGetBlobSidecarsByBlockNumBadRequest().WithPayload(service.BadRequestWithError(err))
-				}
-				sidecars, err = service.BlobSvc.GetBlobSidecarsByBlockNumOrSlot(slot, indicesInx)
-				if err != nil {
-					return blob.NewGetBlobSidecarsByBlockNumInternalServerError().WithPayload(service.InternalError())
-				}
-			}
-			payload := models.GetBlobSideCarsResponse{
-				Data: sidecars,
-			}
-			return blob.NewGetBlobSidecarsByBlockNumOK().WithPayload(&payload)
-		}
+// errorResponder writes the error of a service call with the status code of its reason, the server errors are logged
+func errorResponder(err error, format string, args ...interface{}) middleware.Responder {
+	payload := service.ErrorPayload(err)
+	// the client going away is not an error of the server
+	if payload.Code >= http.StatusInternalServerError && !errors.Is(err, context.Canceled) {
+		logging.Logger.Errorf("%s, err=%s", fmt.Sprintf(format, args...), err.Error())
 	}
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+		if retryAfter := service.RetryAfter(err); retryAfter > 0 {
+			rw.Header().Set("Retry-After", strconv.Itoa(int(math.Ceil(retryAfter.Seconds()))))
+		}
+		rw.WriteHeader(int(payload.Code))
+		if err := producer.Produce(rw, payload); err != nil {
+			logging.Logger.Errorf("failed to write the error response, err=%s", err.Error())
+		}
+	})
 }
 
-func HandleGetBSCBlobSidecars() func(params blob.GetBSCBlobSidecarsByBlockNumParams) middleware.Responder {
-	return func(params blob.GetBSCBlobSidecarsByBlockNumParams) middleware.Responder {
+func getBlobSidecars(params blob.GetBlobSidecarsByBlockNumParams) middleware.Responder {
+	blockID := params.BlockID
+	indices := params.Indices
+	var (
+		root       []byte
+		err        error
+		sidecars   []*models.Sidecar
+		archiveLag *int64
+		slot       uint64
+	)
+
+	respondWithSSZ := middleware.NegotiateContentType(params.HTTPRequest, []string{runtime.JSONMime, runtime.DefaultMime}, runtime.JSONMime) == runtime.DefaultMime
+	// BSC blocks have no consensus version, nor the beacon block header of a BlobSidecar
+	if respondWithSSZ && service.BlobSvc.Chain() == config.BSC {
+		return blob.NewGetBlobSidecarsByBlockNumNotAcceptable().WithPayload(service.NotAcceptableWithError(errors.New("SSZ encoding is only supported on ETH")))
+	}
 
-		rpcRequest := params.Body
-		if rpcRequest.Params == nil {
-			return blob.NewGetBSCBlobSidecarsByBlockNumOK().WithPayload(
-				&models.RPCResponse{
-					ID:      rpcRequest.ID,
-					Jsonrpc: rpcRequest.Jsonrpc,
-					Error: &models.RPCError{
-						Code:    -32600,
-						Message: "Invalid request",
-					},
-				},
-			)
+	indicesInx := make([]int64, 0)
+	for _, idx := range indices {
+		i, err := util.StringToInt64(idx)
+		if err != nil {
+			return blob.NewGetBlobSidecarsByBlockNumBadRequest().WithPayload(service.BadRequestWithError(err))
 		}
+		indicesInx = append(indicesInx, i)
+	}
 
-		switch rpcRequest.Method {
-		case "eth_getBlobSidecars":
-			blockNum, err := util.HexToUint64(rpcRequest.Params[0])
-			if err != nil {
-				return blob.NewGetBSCBlobSidecarsByBlockNumOK().WithPayload(
-					&models.RPCResponse{
-						ID:      rpcRequest.ID,
-						Jsonrpc: rpcRequest.Jsonrpc,
-						Error: &models.RPCError{
-							Code:    -32602,
-							Message: "invalid argument",
-						},
-					},
-				)
+	switch blockID {
+	case service.BlockIDHead, service.BlockIDFinalized, service.BlockIDGenesis:
+		slot, archiveLag, err = service.BlobSvc.ResolveBlockIdentifier(params.HTTPRequest.Context(), blockID)
+		if err != nil {
+			return errorResponder(err, "failed to resolve block_id %s", blockID)
+		}
+		sidecars, err = service.BlobSvc.GetBlobSidecarsByBlockNumOrSlot(params.HTTPRequest.Context(), slot, indicesInx)
+		if err != nil {
+			return errorResponder(err, "failed to get blob sidecars of block_id %s", blockID)
+		}
+	default:
+		root, err = hexutil.Decode(blockID)
+		if err == nil {
+			if len(root) != types.RootLength {
+				return blob.NewGetBlobSidecarsByBlockNumBadRequest().WithPayload(service.BadRequestWithError(fmt.Errorf("invalid block root of length %d", len(root))))
 			}
-			sidecars, err := service.BlobSvc.GetBlobSidecarsByBlockNumOrSlot(blockNum, nil)
+			sidecars, err = service.BlobSvc.GetBlobSidecarsByRoot(params.HTTPRequest.Context(), hex.EncodeToString(root), indicesInx)
 			if err != nil {
-				return blob.NewGetBlobSidecarsByBlockNumInternalServerError().WithPayload(service.InternalError())
+				return errorResponder(err, "failed to get blob sidecars of block_id %s", blockID)
 			}
-			// group sidecars by tx hash
-			bscTxSidecars := make(map[string]*models.BSCBlobTxSidecar)
-			for _, sidecar := range sidecars {
-				txSidecar, ok := bscTxSidecars[sidecar.TxHash]
-				if !ok {
-					txSidecar = &models.BSCBlobTxSidecar{
-						BlobSidecar: &models.BSCBlobSidecar{},
-						TxHash:      sidecar.TxHash,
-					}
-					bscTxSidecars[sidecar.TxHash] = txSidecar
-				}
-				txSidecar.BlobSidecar.Blobs = append(txSidecar.BlobSidecar.Blobs, sidecar.Blob)
-				txSidecar.BlobSidecar.Commitments = append(txSidecar.BlobSidecar.Commitments, sidecar.KzgCommitment)
-				txSidecar.BlobSidecar.Proofs = append(txSidecar.BlobSidecar.Proofs, sidecar.KzgProof)
-				txSidecar.TxIndex = util.Int64ToHex(sidecar.TxIndex)
-				txSidecar.BlockNumber = rpcRequest.Params[0]
+			if len(sidecars) > 0 && sidecars[0].SignedBlockHeader != nil && sidecars[0].SignedBlockHeader.Message != nil {
+				slot, _ = util.StringToUint64(sidecars[0].SignedBlockHeader.Message.Slot)
 			}
-			// convert txSidecars to array
-			txSidecarsArr := make([]*models.BSCBlobTxSidecar, 0)
-			for _, txSidecar := range bscTxSidecars {
-				txSidecarsArr = append(txSidecarsArr, txSidecar)
+		} else {
+			slot, err = util.StringToUint64(blockID)
+			if err != nil {
+				return blob.NewGetBlobSidecarsByBlockNumBadRequest().WithPayload(service.BadRequestWithError(err))
 			}
-			response := &models.RPCResponse{
-				ID:      rpcRequest.ID,
-				Jsonrpc: rpcRequest.Jsonrpc,
-				Result:  txSidecarsArr,
+			sidecars, err = service.BlobSvc.GetBlobSidecarsByBlockNumOrSlot(params.HTTPRequest.Context(), slot, indicesInx)
+			if err != nil {
+				return errorResponder(err, "failed to get blob sidecars of block_id %s", blockID)
 			}
-			return blob.NewGetBSCBlobSidecarsByBlockNumOK().WithPayload(response)
-		default:
-			return blob.NewGetBSCBlobSidecarsByBlockNumOK().WithPayload(
-				&models.RPCResponse{
-					ID:      rpcRequest.ID,
-					Jsonrpc: rpcRequest.Jsonrpc,
-					Error: &models.RPCError{
-						Code:    -32601,
-						Message: "method not supported",
-					},
-				},
-			)
 		}
 	}
+	version := service.BlobSvc.ConsensusVersion(slot)
+	if respondWithSSZ {
+		sszBytes, err := service.EncodeBlobSidecarsSSZ(sidecars)
+		if err != nil {
+			logging.Logger.Errorf("failed to encode blob sidecars of block_id %s, err=%s", blockID, err.Error())
+			return blob.NewGetBlobSidecarsByBlockNumInternalServerError().WithPayload(service.InternalError())
+		}
+		return &sszResponder{data: sszBytes, version: version}
+	}
+	payload := models.GetBlobSideCarsResponse{
+		Data:       sidecars,
+		ArchiveLag: archiveLag,
+	}
+	ok := blob.NewGetBlobSidecarsByBlockNumOK().WithPayload(&payload)
+	return middleware.ResponderFunc(func(rw http.ResponseWriter, producer runtime.Producer) {
+		if version != "" {
+			rw.Header().Set(api.VersionHeader, version)
+		}
+		ok.WriteResponse(rw, producer)
+	})
+}
+
+// sszResponder writes the SSZ encoded blob sidecars
+type sszResponder struct {
+	data    []byte
+	version string
+}
+
+func (r *sszResponder) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	rw.Header().Set(runtime.HeaderContentType, runtime.DefaultMime)
+	rw.Header().Set("Content-Length", strconv.Itoa(len(r.data)))
+	rw.Header().Set(api.VersionHeader, r.version)
+	rw.WriteHeader(http.StatusOK)
+	if _, err := rw.Write(r.data); err != nil {
+		logging.Logger.Errorf("failed to write the SSZ response, err=%s", err.Error())
+	}
+}
+
+func HandleGetBlobsByVersionedHashes() func(params blob.GetBlobsByVersionedHashesParams) middleware.Responder {
+	return func(params blob.GetBlobsByVersionedHashesParams) middleware.Responder {
+		hashes, err := service.ParseVersionedHashes(params.VersionedHash)
+		if err != nil {
+			return blob.NewGetBlobsByVersionedHashesBadRequest().WithPayload(service.BadRequestWithError(err))
+		}
+		blobs, err := service.BlobSvc.GetBlobsByVersionedHashes(params.HTTPRequest.Context(), hashes)
+		if err != nil {
+			return errorResponder(err, "failed to get blobs by versioned hashes")
+		}
+		if len(blobs) == 0 {
+			return blob.NewGetBlobsByVersionedHashesNotFound().WithPayload(service.NotFoundWithError(errors.New("no blob archived for the versioned hashes")))
+		}
+		return blob.NewGetBlobsByVersionedHashesOK().WithPayload(&models.GetBlobsByVersionedHashesResponse{Data: blobs})
+	}
+}
+
+func HandleGetBlobsByTxHash() func(params blob.GetBlobsByTxHashParams) middleware.Responder {
+	return func(params blob.GetBlobsByTxHashParams) middleware.Responder {
+		txHash, err := hexutil.Decode(params.TxHash)
+		if err != nil {
+			return blob.NewGetBlobsByTxHashBadRequest().WithPayload(service.BadRequestWithError(err))
+		}
+		if len(txHash) != common.HashLength {
+			return blob.NewGetBlobsByTxHashBadRequest().WithPayload(service.BadRequestWithError(fmt.Errorf("invalid tx hash of length %d", len(txHash))))
+		}
+		// tx hashes are saved to DB without 0x
+		blobs, err := service.BlobSvc.GetBlobsByTxHash(params.HTTPRequest.Context(), hex.EncodeToString(txHash))
+		if err != nil {
+			return errorResponder(err, "failed to get blobs by tx hash %s", params.TxHash)
+		}
+		if len(blobs) == 0 {
+			return blob.NewGetBlobsByTxHashNotFound().WithPayload(service.NotFoundWithError(fmt.Errorf("no blob archived for tx %s", params.TxHash)))
+		}
+		return blob.NewGetBlobsByTxHashOK().WithPayload(&models.GetBlobsByTxHashResponse{Data: blobs})
+	}
+}
+
+func HandleGetBlobsByAddress() func(params blob.GetBlobsByAddressParams) middleware.Responder {
+	return func(params blob.GetBlobsByAddressParams) middleware.Responder {
+		if !common.IsHexAddress(params.Address) {
+			return blob.NewGetBlobsByAddressBadRequest().WithPayload(service.BadRequestWithError(fmt.Errorf("invalid address %s", params.Address)))
+		}
+		// addresses are saved to DB in the checksum format
+		address := common.HexToAddress(params.Address).Hex()
+		role := db.AddressRoleAny
+		if params.Role != nil {
+			role = db.AddressRole(*params.Role)
+		}
+		page, pageSize := int(*params.Page), int(*params.PageSize)
+		blobs, hasMore, err := service.BlobSvc.GetBlobsByAddress(params.HTTPRequest.Context(), address, role, page, pageSize)
+		if err != nil {
+			return errorResponder(err, "failed to get blobs by address %s", address)
+		}
+		return blob.NewGetBlobsByAddressOK().WithPayload(&models.GetBlobsByAddressResponse{
+			Data:     blobs,
+			Page:     int64(page),
+			PageSize: int64(pageSize),
+			HasMore:  hasMore,
+		})
+	}
 }
@@ -0,0 +1,176 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/bnb-chain/blob-hub/logging"
+	"github.com/bnb-chain/blob-hub/metrics"
+	"github.com/bnb-chain/blob-hub/models"
+	"github.com/bnb-chain/blob-hub/restapi/operations/blob"
+	"github.com/bnb-chain/blob-hub/service"
+	"github.com/bnb-chain/blob-hub/util"
+)
+
+const eventStreamMime = "text/event-stream"
+
+// sseKeepalive is a comment line, which EventSource ignores
+var sseKeepalive = []byte(": keepalive\n\n")
+
+// rpcNamespace is the namespace of the websocket subscriptions, e.g. eth_subscribe("newArchivedBlocks")
+const rpcNamespace = "eth"
+
+func HandleSubscribeBlockEvents() func(params blob.SubscribeBlockEventsParams) middleware.Responder {
+	return func(params blob.SubscribeBlockEventsParams) middleware.Responder {
+		after := params.After
+		if params.LastEventID != nil && *params.LastEventID != "" {
+			id, err := util.StringToInt64(strings.TrimSpace(*params.LastEventID))
+			if err != nil || id < 0 {
+				return jsonResponder(blob.NewSubscribeBlockEventsBadRequest().WithPayload(service.BadRequestWithError(fmt.Errorf("invalid Last-Event-ID %s", *params.LastEventID))))
+			}
+			after = &id
+		}
+		return &blockEventResponder{
+			ctx:   params.HTTPRequest.Context(),
+			after: after,
+			types: params.Types,
+		}
+	}
+}
+
+// blockEventResponder sends the block events as Server-Sent Events until the client goes away. The status is only
+// written once subscribed, so that a subscription refused is still answered with an error.
+type blockEventResponder struct {
+	ctx   context.Context
+	after *int64
+	types []string
+}
+
+func (r *blockEventResponder) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	controller := http.NewResponseController(rw)
+	started := false
+	var frame bytes.Buffer
+	err := service.FeedSvc.Subscribe(r.ctx, metrics.TransportSSE, r.after, r.types, func(event *models.BlockEvent) error {
+		if !started {
+			started = true
+			rw.Header().Set(runtime.HeaderContentType, eventStreamMime)
+			rw.Header().Set("Cache-Control", "no-cache")
+			// let the proxies like nginx pass the events through rather than buffer them
+			rw.Header().Set("X-Accel-Buffering", "no")
+			rw.WriteHeader(http.StatusOK)
+		}
+		if err := controller.SetWriteDeadline(time.Now().Add(streamWriteTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		frame.Reset()
+		if event == nil {
+			frame.Write(sseKeepalive)
+		} else {
+			data, err := json.Marshal(event)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(&frame, "id: %d\nevent: %s\ndata: %s\n\n", event.ID, event.Type, data)
+		}
+		if _, err := rw.Write(frame.Bytes()); err != nil {
+			return err
+		}
+		if err := controller.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		return nil
+	})
+	if !started {
+		jsonResponder(errorResponder(err, "failed to subscribe to block events")).WriteResponse(rw, nil)
+		return
+	}
+	// the client going away ends a subscription
+	if r.ctx.Err() == nil {
+		logging.Logger.Errorf("block event subscription failed, err=%s", err.Error())
+	}
+}
+
+// NewWebsocketServer serves the subscriptions to the block events over websocket the way BSC nodes serve eth_subscribe,
+// the topics are newArchivedBlocks and newVerifiedBlocks. go-ethereum pings the connections to keep them alive.
+func NewWebsocketServer() *rpc.Server {
+	server := rpc.NewServer()
+	if err := server.RegisterName(rpcNamespace, &blockEventAPI{}); err != nil {
+		panic(err)
+	}
+	return server
+}
+
+type blockEventAPI struct{}
+
+// NewArchivedBlocks subscribes to the blocks archived, after the event of id after if set
+func (api *blockEventAPI) NewArchivedBlocks(ctx context.Context, after *int64) (*rpc.Subscription, error) {
+	return subscribeBlockEvents(ctx, after, models.BlockEventTypeBlockArchived)
+}
+
+// NewVerifiedBlocks subscribes to the blocks verified, after the event of id after if set
+func (api *blockEventAPI) NewVerifiedBlocks(ctx context.Context, after *int64) (*rpc.Subscription, error) {
+	return subscribeBlockEvents(ctx, after, models.BlockEventTypeBlockVerified)
+}
+
+// subscribeBlockEvents returns once subscribed, so that a subscription refused is answered with an error. The events
+// are sent until the client unsubscribes or goes away.
+func subscribeBlockEvents(ctx context.Context, after *int64, eventType string) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+	if after != nil && *after < 0 {
+		return nil, fmt.Errorf("invalid event id %d", *after)
+	}
+	sub := notifier.CreateSubscription()
+	subCtx, cancel := context.WithCancel(context.Background())
+	go func() {
+		select {
+		case <-sub.Err():
+			cancel()
+		case <-subCtx.Done():
+		}
+	}()
+
+	var (
+		once       sync.Once
+		subscribed = make(chan struct{})
+		failed     = make(chan error, 1)
+		notifyErr  error // go-ethereum closes the connection on a failed notification
+	)
+	go func() {
+		defer cancel()
+		err := service.FeedSvc.Subscribe(subCtx, metrics.TransportWebsocket, after, []string{eventType}, func(event *models.BlockEvent) error {
+			once.Do(func() { close(subscribed) })
+			if event == nil {
+				return nil
+			}
+			notifyErr = notifier.Notify(sub.ID, event)
+			return notifyErr
+		})
+		failed <- err
+		if subCtx.Err() == nil && notifyErr == nil && service.ReasonOf(err) == service.ReasonInternal {
+			logging.Logger.Errorf("block event subscription failed, err=%s", err.Error())
+		}
+	}()
+	select {
+	case <-subscribed:
+		return sub, nil
+	case err := <-failed:
+		if service.ReasonOf(err) == service.ReasonInternal {
+			return nil, errors.New("internal error")
+		}
+		return nil, err
+	}
+}
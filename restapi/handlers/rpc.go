@@ -0,0 +1,238 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/blob-hub/logging"
+	"github.com/bnb-chain/blob-hub/models"
+	"github.com/bnb-chain/blob-hub/restapi/operations/blob"
+	"github.com/bnb-chain/blob-hub/service"
+	"github.com/bnb-chain/blob-hub/util"
+)
+
+const (
+	rpcVersion = "2.0"
+
+	// rpcMaxBatchSize caps the requests of a batch
+	rpcMaxBatchSize = 100
+
+	methodGetBlobSidecars        = "eth_getBlobSidecars"
+	methodGetBlobSidecarByTxHash = "eth_getBlobSidecarByTxHash"
+
+	// block tags, resolved from the archive like the identifiers of the beacon API
+	blockTagLatest    = "latest"
+	blockTagSafe      = "safe"
+	blockTagFinalized = "finalized"
+	blockTagEarliest  = "earliest"
+	blockTagPending   = "pending"
+)
+
+// standard JSON-RPC error codes
+const (
+	rpcInvalidRequest = -32600
+	rpcMethodNotFound = -32601
+	rpcInvalidParams  = -32602
+	rpcInternalError  = -32603
+)
+
+// rpcNull is the result of a request for a block or tx not archived, as BSC nodes return null rather than an error
+var rpcNull = json.RawMessage("null")
+
+func HandleGetBSCBlobSidecars() func(params blob.GetBSCBlobSidecarsByBlockNumParams) middleware.Responder {
+	return func(params blob.GetBSCBlobSidecarsByBlockNumParams) middleware.Responder {
+		body, err := json.Marshal(params.Body)
+		if err != nil {
+			return blob.NewGetBSCBlobSidecarsByBlockNumOK().WithPayload(rpcErrorResponse(nil, rpcInvalidRequest, "invalid request"))
+		}
+		if !bytes.HasPrefix(bytes.TrimSpace(body), []byte("[")) {
+			return blob.NewGetBSCBlobSidecarsByBlockNumOK().WithPayload(serveRPC(params.HTTPRequest.Context(), body))
+		}
+
+		var batch []json.RawMessage
+		if err = json.Unmarshal(body, &batch); err != nil || len(batch) == 0 {
+			return blob.NewGetBSCBlobSidecarsByBlockNumOK().WithPayload(rpcErrorResponse(nil, rpcInvalidRequest, "invalid request"))
+		}
+		if len(batch) > rpcMaxBatchSize {
+			return blob.NewGetBSCBlobSidecarsByBlockNumOK().WithPayload(rpcErrorResponse(nil, rpcInvalidRequest, fmt.Sprintf("batch too large, the maximum is %d", rpcMaxBatchSize)))
+		}
+		responses := make([]*models.RPCResponse, 0, len(batch))
+		for _, request := range batch {
+			responses = append(responses, serveRPC(params.HTTPRequest.Context(), request))
+		}
+		return middleware.ResponderFunc(func(rw http.ResponseWriter, _ runtime.Producer) {
+			rw.WriteHeader(http.StatusOK)
+			if err := runtime.JSONProducer().Produce(rw, responses); err != nil {
+				logging.Logger.Errorf("failed to write the batch response, err=%s", err.Error())
+			}
+		})
+	}
+}
+
+// serveRPC serves a single JSON-RPC request
+func serveRPC(ctx context.Context, body []byte) *models.RPCResponse {
+	var rpcRequest models.RPCRequest
+	if err := json.Unmarshal(body, &rpcRequest); err != nil {
+		return rpcErrorResponse(nil, rpcInvalidRequest, "invalid request")
+	}
+	if rpcRequest.Jsonrpc != rpcVersion || rpcRequest.Method == "" {
+		return rpcErrorResponse(rpcRequest.ID, rpcInvalidRequest, "invalid request")
+	}
+
+	var (
+		result interface{}
+		err    error
+	)
+	switch rpcRequest.Method {
+	case methodGetBlobSidecars:
+		result, err = rpcGetBlobSidecars(ctx, rpcRequest.Params)
+	case methodGetBlobSidecarByTxHash:
+		result, err = rpcGetBlobSidecarByTxHash(ctx, rpcRequest.Params)
+	default:
+		return rpcErrorResponse(rpcRequest.ID, rpcMethodNotFound, fmt.Sprintf("the method %s does not exist/is not available", rpcRequest.Method))
+	}
+	var paramsErr *rpcParamsError
+	switch {
+	case errors.As(err, &paramsErr):
+		return rpcErrorResponse(rpcRequest.ID, rpcInvalidParams, paramsErr.Error())
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		result = rpcNull
+	case err != nil:
+		logging.Logger.Errorf("failed to serve %s, params=%v, err=%s", rpcRequest.Method, rpcRequest.Params, err.Error())
+		return rpcErrorResponse(rpcRequest.ID, rpcInternalError, "internal error")
+	}
+	return &models.RPCResponse{
+		ID:      rpcRequest.ID,
+		Jsonrpc: rpcVersion,
+		Result:  result,
+	}
+}
+
+// rpcGetBlobSidecars serves eth_getBlobSidecars(blockNrOrHash, fullBlob)
+func rpcGetBlobSidecars(ctx context.Context, params []interface{}) (interface{}, error) {
+	if len(params) == 0 || len(params) > 2 {
+		return nil, &rpcParamsError{msg: fmt.Sprintf("expected 1 or 2 params, got %d", len(params))}
+	}
+	fullBlob, err := parseFullBlob(params)
+	if err != nil {
+		return nil, err
+	}
+	blockNum, err := resolveBlockNumberOrHash(ctx, params[0])
+	if err != nil {
+		return nil, err
+	}
+	return service.BlobSvc.GetBSCBlobTxSidecars(ctx, blockNum, fullBlob)
+}
+
+// rpcGetBlobSidecarByTxHash serves eth_getBlobSidecarByTxHash(txHash, fullBlob)
+func rpcGetBlobSidecarByTxHash(ctx context.Context, params []interface{}) (interface{}, error) {
+	if len(params) == 0 || len(params) > 2 {
+		return nil, &rpcParamsError{msg: fmt.Sprintf("expected 1 or 2 params, got %d", len(params))}
+	}
+	fullBlob, err := parseFullBlob(params)
+	if err != nil {
+		return nil, err
+	}
+	txHashStr, ok := params[0].(string)
+	if !ok {
+		return nil, &rpcParamsError{msg: "invalid tx hash"}
+	}
+	txHash, err := hexutil.Decode(txHashStr)
+	if err != nil || len(txHash) != common.HashLength {
+		return nil, &rpcParamsError{msg: fmt.Sprintf("invalid tx hash %s", txHashStr)}
+	}
+	// tx hashes are saved to DB without 0x
+	return service.BlobSvc.GetBSCBlobTxSidecarByTxHash(ctx, hex.EncodeToString(txHash), fullBlob)
+}
+
+// parseFullBlob returns the optional fullBlob param, true by default
+func parseFullBlob(params []interface{}) (bool, error) {
+	if len(params) < 2 || params[1] == nil {
+		return true, nil
+	}
+	fullBlob, ok := params[1].(bool)
+	if !ok {
+		return false, &rpcParamsError{msg: "invalid fullBlob, expected a boolean"}
+	}
+	return fullBlob, nil
+}
+
+// resolveBlockNumberOrHash returns the block number of a block number, a block tag, a block hash, or an object of
+// blockNumber or blockHash
+func resolveBlockNumberOrHash(ctx context.Context, param interface{}) (uint64, error) {
+	switch p := param.(type) {
+	case string:
+		switch p {
+		case blockTagLatest:
+			return resolveBlockTag(ctx, service.BlockIDHead)
+		case blockTagSafe, blockTagFinalized:
+			return resolveBlockTag(ctx, service.BlockIDFinalized)
+		case blockTagEarliest:
+			return resolveBlockTag(ctx, service.BlockIDGenesis)
+		case blockTagPending:
+			return 0, &rpcParamsError{msg: "pending block is not archived"}
+		}
+		if len(p) == 2+2*common.HashLength {
+			return resolveBlockHash(ctx, p)
+		}
+		blockNum, err := util.HexToUint64(p)
+		if err != nil || !strings.HasPrefix(p, "0x") {
+			return 0, &rpcParamsError{msg: fmt.Sprintf("invalid block number or hash %s", p)}
+		}
+		return blockNum, nil
+	case map[string]interface{}:
+		if blockHash, ok := p["blockHash"].(string); ok {
+			return resolveBlockHash(ctx, blockHash)
+		}
+		if blockNum, ok := p["blockNumber"]; ok {
+			return resolveBlockNumberOrHash(ctx, blockNum)
+		}
+	}
+	return 0, &rpcParamsError{msg: "invalid block number or hash"}
+}
+
+func resolveBlockTag(ctx context.Context, identifier string) (uint64, error) {
+	blockNum, _, err := service.BlobSvc.ResolveBlockIdentifier(ctx, identifier)
+	return blockNum, err
+}
+
+func resolveBlockHash(ctx context.Context, blockHashStr string) (uint64, error) {
+	blockHash, err := hexutil.Decode(blockHashStr)
+	if err != nil || len(blockHash) != common.HashLength {
+		return 0, &rpcParamsError{msg: fmt.Sprintf("invalid block hash %s", blockHashStr)}
+	}
+	// block hashes are saved to DB without 0x
+	return service.BlobSvc.GetBlockNumByHash(ctx, hex.EncodeToString(blockHash))
+}
+
+// rpcParamsError is returned for the params of a request which are invalid
+type rpcParamsError struct {
+	msg string
+}
+
+func (e *rpcParamsError) Error() string {
+	return e.msg
+}
+
+func rpcErrorResponse(id interface{}, code int64, message string) *models.RPCResponse {
+	return &models.RPCResponse{
+		ID:      id,
+		Jsonrpc: rpcVersion,
+		Error: &models.RPCError{
+			Code:    code,
+			Message: message,
+		},
+	}
+}
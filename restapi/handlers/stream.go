@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/bnb-chain/blob-hub/config"
+	"github.com/bnb-chain/blob-hub/logging"
+	"github.com/bnb-chain/blob-hub/models"
+	"github.com/bnb-chain/blob-hub/restapi/operations/blob"
+	"github.com/bnb-chain/blob-hub/service"
+)
+
+const ndjsonMime = "application/x-ndjson"
+
+// streamWriteTimeout is the write deadline of each blob of a stream, which outlasts the write timeout of the server
+const streamWriteTimeout = 60 * time.Second
+
+// ndjsonEnd is the last line of a complete NDJSON stream, a stream without it was cut and can be resumed
+var ndjsonEnd = []byte("{\"done\":true}\n")
+
+func HandleStreamBlobs() func(params blob.StreamBlobsParams) middleware.Responder {
+	return func(params blob.StreamBlobsParams) middleware.Responder {
+		respondWithSSZ := middleware.NegotiateContentType(params.HTTPRequest, []string{ndjsonMime, runtime.DefaultMime}, ndjsonMime) == runtime.DefaultMime
+		// BSC blocks have no beacon block header to encode a BlobSidecar with
+		if respondWithSSZ && service.BlobSvc.Chain() == config.BSC {
+			return jsonResponder(blob.NewStreamBlobsNotAcceptable().WithPayload(service.NotAcceptableWithError(errors.New("SSZ encoding is only supported on ETH"))))
+		}
+		var cursor *service.StreamCursor
+		if params.Cursor != nil {
+			var err error
+			cursor, err = service.ParseStreamCursor(*params.Cursor)
+			if err != nil {
+				return jsonResponder(blob.NewStreamBlobsBadRequest().WithPayload(service.BadRequestWithError(err)))
+			}
+		}
+		return &blobStreamResponder{
+			ctx:    params.HTTPRequest.Context(),
+			from:   params.From,
+			to:     params.To,
+			cursor: cursor,
+			ssz:    respondWithSSZ,
+		}
+	}
+}
+
+// blobStreamResponder streams the blobs of a range as NDJSON, or as SSZ BlobSidecars each prefixed by its 4-byte little
+// endian length. The status is only written with the first blob, so that an invalid range is still answered with an
+// error. A stream failing midway is cut without its end marker.
+type blobStreamResponder struct {
+	ctx      context.Context
+	from, to uint64
+	cursor   *service.StreamCursor
+	ssz      bool
+}
+
+func (r *blobStreamResponder) WriteResponse(rw http.ResponseWriter, _ runtime.Producer) {
+	controller := http.NewResponseController(rw)
+	started := false
+	start := func() {
+		if started {
+			return
+		}
+		started = true
+		if r.ssz {
+			rw.Header().Set(runtime.HeaderContentType, runtime.DefaultMime)
+		} else {
+			rw.Header().Set(runtime.HeaderContentType, ndjsonMime)
+		}
+		rw.WriteHeader(http.StatusOK)
+	}
+
+	encoder := json.NewEncoder(rw)
+	err := service.BlobSvc.StreamBlobs(r.ctx, r.from, r.to, r.cursor, func(streamed *models.StreamedBlob) error {
+		start()
+		if err := controller.SetWriteDeadline(time.Now().Add(streamWriteTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		if r.ssz {
+			if err := writeSSZFrame(rw, streamed.Sidecar); err != nil {
+				return err
+			}
+		} else if err := encoder.Encode(streamed); err != nil {
+			return err
+		}
+		if err := controller.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		return nil
+	})
+	if err != nil {
+		if !started {
+			jsonResponder(errorResponder(err, "failed to stream blobs of [%d, %d]", r.from, r.to)).WriteResponse(rw, nil)
+			return
+		}
+		// the client going away is not an error of the server
+		if r.ctx.Err() == nil {
+			logging.Logger.Errorf("failed to stream blobs of [%d, %d], err=%s", r.from, r.to, err.Error())
+		}
+		return
+	}
+
+	start()
+	if err = controller.SetWriteDeadline(time.Now().Add(streamWriteTimeout)); err != nil && !errors.Is(err, http.ErrNotSupported) {
+		logging.Logger.Errorf("failed to extend the write deadline of the blob stream, err=%s", err.Error())
+	}
+	end := ndjsonEnd
+	if r.ssz {
+		end = make([]byte, 4) // a zero length frame
+	}
+	if _, err = rw.Write(end); err != nil {
+		logging.Logger.Errorf("failed to end the blob stream, err=%s", err.Error())
+	}
+}
+
+func writeSSZFrame(rw http.ResponseWriter, sidecar *models.Sidecar) error {
+	sidecarBytes, err := service.EncodeBlobSidecarSSZ(sidecar)
+	if err != nil {
+		return err
+	}
+	frame := binary.LittleEndian.AppendUint32(make([]byte, 0, 4+len(sidecarBytes)), uint32(len(sidecarBytes)))
+	_, err = rw.Write(append(frame, sidecarBytes...))
+	return err
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package beacon
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetBlockHeaderHandlerFunc turns a function with the right signature into a get block header handler
+type GetBlockHeaderHandlerFunc func(GetBlockHeaderParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetBlockHeaderHandlerFunc) Handle(params GetBlockHeaderParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetBlockHeaderHandler interface for that can handle valid get block header params
+type GetBlockHeaderHandler interface {
+	Handle(GetBlockHeaderParams) middleware.Responder
+}
+
+// NewGetBlockHeader creates a new http.Handler for the get block header operation
+func NewGetBlockHeader(ctx *middleware.Context, handler GetBlockHeaderHandler) *GetBlockHeader {
+	return &GetBlockHeader{Context: ctx, Handler: handler}
+}
+
+/*
+	GetBlockHeader swagger:route GET /eth/v1/beacon/headers/{block_id} beacon getBlockHeader
+
+Get the header of an archived block
+*/
+type GetBlockHeader struct {
+	Context *middleware.Context
+	Handler GetBlockHeaderHandler
+}
+
+func (o *GetBlockHeader) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetBlockHeaderParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,87 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package beacon
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetBlockHeaderParams creates a new GetBlockHeaderParams object
+//
+// There are no default values defined in the spec.
+func NewGetBlockHeaderParams() GetBlockHeaderParams {
+
+	return GetBlockHeaderParams{}
+}
+
+// GetBlockHeaderParams contains all the bound params for the get block header operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getBlockHeader
+type GetBlockHeaderParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Block identifier. Can be one of: 'head' (the latest archived block), 'genesis' (the first archived block), 'finalized' (the latest verified block), <slot>, <hex encoded blockRoot with 0x prefix>
+	  Required: true
+	  Min Length: 1
+	  In: path
+	*/
+	BlockID string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetBlockHeaderParams() beforehand.
+func (o *GetBlockHeaderParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rBlockID, rhkBlockID, _ := route.Params.GetOK("block_id")
+	if err := o.bindBlockID(rBlockID, rhkBlockID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindBlockID binds and validates parameter BlockID from path.
+func (o *GetBlockHeaderParams) bindBlockID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.BlockID = raw
+
+	if err := o.validateBlockID(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateBlockID carries on validations for parameter BlockID
+func (o *GetBlockHeaderParams) validateBlockID(formats strfmt.Registry) error {
+
+	if err := validate.MinLength("block_id", "path", o.BlockID, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
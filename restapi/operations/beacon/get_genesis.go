@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package beacon
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetGenesisHandlerFunc turns a function with the right signature into a get genesis handler
+type GetGenesisHandlerFunc func(GetGenesisParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetGenesisHandlerFunc) Handle(params GetGenesisParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetGenesisHandler interface for that can handle valid get genesis params
+type GetGenesisHandler interface {
+	Handle(GetGenesisParams) middleware.Responder
+}
+
+// NewGetGenesis creates a new http.Handler for the get genesis operation
+func NewGetGenesis(ctx *middleware.Context, handler GetGenesisHandler) *GetGenesis {
+	return &GetGenesis{Context: ctx, Handler: handler}
+}
+
+/*
+	GetGenesis swagger:route GET /eth/v1/beacon/genesis beacon getGenesis
+
+Get the genesis of the configured network
+*/
+type GetGenesis struct {
+	Context *middleware.Context
+	Handler GetGenesisHandler
+}
+
+func (o *GetGenesis) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetGenesisParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
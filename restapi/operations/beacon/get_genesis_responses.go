@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package beacon
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/bnb-chain/blob-hub/models"
+)
+
+// GetGenesisOKCode is the HTTP code returned for type GetGenesisOK
+const GetGenesisOKCode int = 200
+
+/*
+GetGenesisOK successful operation
+
+swagger:response getGenesisOK
+*/
+type GetGenesisOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.GetGenesisResponse `json:"body,omitempty"`
+}
+
+// NewGetGenesisOK creates GetGenesisOK with default headers values
+func NewGetGenesisOK() *GetGenesisOK {
+
+	return &GetGenesisOK{}
+}
+
+// WithPayload adds the payload to the get genesis o k response
+func (o *GetGenesisOK) WithPayload(payload *models.GetGenesisResponse) *GetGenesisOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get genesis o k response
+func (o *GetGenesisOK) SetPayload(payload *models.GetGenesisResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetGenesisOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetGenesisNotFoundCode is the HTTP code returned for type GetGenesisNotFound
+const GetGenesisNotFoundCode int = 404
+
+/*
+GetGenesisNotFound network not configured
+
+swagger:response getGenesisNotFound
+*/
+type GetGenesisNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetGenesisNotFound creates GetGenesisNotFound with default headers values
+func NewGetGenesisNotFound() *GetGenesisNotFound {
+
+	return &GetGenesisNotFound{}
+}
+
+// WithPayload adds the payload to the get genesis not found response
+func (o *GetGenesisNotFound) WithPayload(payload *models.Error) *GetGenesisNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get genesis not found response
+func (o *GetGenesisNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetGenesisNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package beacon
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetNodeVersionHandlerFunc turns a function with the right signature into a get node version handler
+type GetNodeVersionHandlerFunc func(GetNodeVersionParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetNodeVersionHandlerFunc) Handle(params GetNodeVersionParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetNodeVersionHandler interface for that can handle valid get node version params
+type GetNodeVersionHandler interface {
+	Handle(GetNodeVersionParams) middleware.Responder
+}
+
+// NewGetNodeVersion creates a new http.Handler for the get node version operation
+func NewGetNodeVersion(ctx *middleware.Context, handler GetNodeVersionHandler) *GetNodeVersion {
+	return &GetNodeVersion{Context: ctx, Handler: handler}
+}
+
+/*
+	GetNodeVersion swagger:route GET /eth/v1/node/version beacon getNodeVersion
+
+Get the version of the blob hub
+*/
+type GetNodeVersion struct {
+	Context *middleware.Context
+	Handler GetNodeVersionHandler
+}
+
+func (o *GetNodeVersion) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetNodeVersionParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package beacon
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetSpecHandlerFunc turns a function with the right signature into a get spec handler
+type GetSpecHandlerFunc func(GetSpecParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetSpecHandlerFunc) Handle(params GetSpecParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetSpecHandler interface for that can handle valid get spec params
+type GetSpecHandler interface {
+	Handle(GetSpecParams) middleware.Responder
+}
+
+// NewGetSpec creates a new http.Handler for the get spec operation
+func NewGetSpec(ctx *middleware.Context, handler GetSpecHandler) *GetSpec {
+	return &GetSpec{Context: ctx, Handler: handler}
+}
+
+/*
+	GetSpec swagger:route GET /eth/v1/config/spec beacon getSpec
+
+Get the spec values of the configured network
+*/
+type GetSpec struct {
+	Context *middleware.Context
+	Handler GetSpecHandler
+}
+
+func (o *GetSpec) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetSpecParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
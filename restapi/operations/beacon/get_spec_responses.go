@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package beacon
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/bnb-chain/blob-hub/models"
+)
+
+// GetSpecOKCode is the HTTP code returned for type GetSpecOK
+const GetSpecOKCode int = 200
+
+/*
+GetSpecOK successful operation
+
+swagger:response getSpecOK
+*/
+type GetSpecOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.GetSpecResponse `json:"body,omitempty"`
+}
+
+// NewGetSpecOK creates GetSpecOK with default headers values
+func NewGetSpecOK() *GetSpecOK {
+
+	return &GetSpecOK{}
+}
+
+// WithPayload adds the payload to the get spec o k response
+func (o *GetSpecOK) WithPayload(payload *models.GetSpecResponse) *GetSpecOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get spec o k response
+func (o *GetSpecOK) SetPayload(payload *models.GetSpecResponse) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetSpecOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetSpecNotFoundCode is the HTTP code returned for type GetSpecNotFound
+const GetSpecNotFoundCode int = 404
+
+/*
+GetSpecNotFound network not configured
+
+swagger:response getSpecNotFound
+*/
+type GetSpecNotFound struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetSpecNotFound creates GetSpecNotFound with default headers values
+func NewGetSpecNotFound() *GetSpecNotFound {
+
+	return &GetSpecNotFound{}
+}
+
+// WithPayload adds the payload to the get spec not found response
+func (o *GetSpecNotFound) WithPayload(payload *models.Error) *GetSpecNotFound {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get spec not found response
+func (o *GetSpecNotFound) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetSpecNotFound) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(404)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
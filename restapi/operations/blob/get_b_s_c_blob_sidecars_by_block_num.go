@@ -32,7 +32,7 @@ func NewGetBSCBlobSidecarsByBlockNum(ctx *middleware.Context, handler GetBSCBlob
 /*
 	GetBSCBlobSidecarsByBlockNum swagger:route POST / blob getBSCBlobSidecarsByBlockNum
 
-Get BSC blob sidecars by block num
+Get BSC blob sidecars by JSON-RPC, eth_getBlobSidecars and eth_getBlobSidecarByTxHash are supported
 */
 type GetBSCBlobSidecarsByBlockNum struct {
 	Context *middleware.Context
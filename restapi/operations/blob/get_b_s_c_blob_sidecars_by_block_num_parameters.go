@@ -12,9 +12,6 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
-	"github.com/go-openapi/validate"
-
-	"github.com/bnb-chain/blob-hub/models"
 )
 
 // NewGetBSCBlobSidecarsByBlockNumParams creates a new GetBSCBlobSidecarsByBlockNumParams object
@@ -34,11 +31,11 @@ type GetBSCBlobSidecarsByBlockNumParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
-	/*
+	/*A JSON-RPC request as RPCRequest, or a batch array of them
 	  Required: true
 	  In: body
 	*/
-	Body *models.RPCRequest
+	Body interface{}
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -52,7 +49,7 @@ func (o *GetBSCBlobSidecarsByBlockNumParams) BindRequest(r *http.Request, route
 
 	if runtime.HasBody(r) {
 		defer r.Body.Close()
-		var body models.RPCRequest
+		var body interface{}
 		if err := route.Consumer.Consume(r.Body, &body); err != nil {
 			if err == io.EOF {
 				res = append(res, errors.Required("body", "body", ""))
@@ -60,19 +57,8 @@ func (o *GetBSCBlobSidecarsByBlockNumParams) BindRequest(r *http.Request, route
 				res = append(res, errors.NewParseError("body", "body", "", err))
 			}
 		} else {
-			// validate body object
-			if err := body.Validate(route.Formats); err != nil {
-				res = append(res, err)
-			}
-
-			ctx := validate.WithOperationRequest(r.Context())
-			if err := body.ContextValidate(ctx, route.Formats); err != nil {
-				res = append(res, err)
-			}
-
-			if len(res) == 0 {
-				o.Body = &body
-			}
+			// no validation on generic interface
+			o.Body = body
 		}
 	} else {
 		res = append(res, errors.Required("body", "body", ""))
@@ -17,7 +17,7 @@ import (
 const GetBSCBlobSidecarsByBlockNumOKCode int = 200
 
 /*
-GetBSCBlobSidecarsByBlockNumOK successful operation
+GetBSCBlobSidecarsByBlockNumOK successful operation, a batch array of RPCResponse for a batch request
 
 swagger:response getBSCBlobSidecarsByBlockNumOK
 */
@@ -33,7 +33,7 @@ type GetBlobSidecarsByBlockNumParams struct {
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
 
-	/*Block identifier. Can be one of: 'head' (canonical head in node's view), 'genesis', 'finalized', <slot>, <hex encoded blockRoot with 0x prefix>
+	/*Block identifier. Can be one of: 'head' (the latest archived block), 'genesis' (the first archived block), 'finalized' (the latest verified block), <slot>, <hex encoded blockRoot with 0x prefix>
 	  Required: true
 	  Min Length: 1
 	  In: path
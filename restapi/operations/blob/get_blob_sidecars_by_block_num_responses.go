@@ -17,7 +17,7 @@ import (
 const GetBlobSidecarsByBlockNumOKCode int = 200
 
 /*
-GetBlobSidecarsByBlockNumOK successful operation
+GetBlobSidecarsByBlockNumOK successful operation, the sidecars are SSZ encoded when application/octet-stream is accepted
 
 swagger:response getBlobSidecarsByBlockNumOK
 */
@@ -148,6 +148,96 @@ func (o *GetBlobSidecarsByBlockNumNotFound) WriteResponse(rw http.ResponseWriter
 	}
 }
 
+// GetBlobSidecarsByBlockNumNotAcceptableCode is the HTTP code returned for type GetBlobSidecarsByBlockNumNotAcceptable
+const GetBlobSidecarsByBlockNumNotAcceptableCode int = 406
+
+/*
+GetBlobSidecarsByBlockNumNotAcceptable SSZ encoding is not acceptable on the chain
+
+swagger:response getBlobSidecarsByBlockNumNotAcceptable
+*/
+type GetBlobSidecarsByBlockNumNotAcceptable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetBlobSidecarsByBlockNumNotAcceptable creates GetBlobSidecarsByBlockNumNotAcceptable with default headers values
+func NewGetBlobSidecarsByBlockNumNotAcceptable() *GetBlobSidecarsByBlockNumNotAcceptable {
+
+	return &GetBlobSidecarsByBlockNumNotAcceptable{}
+}
+
+// WithPayload adds the payload to the get blob sidecars by block num not acceptable response
+func (o *GetBlobSidecarsByBlockNumNotAcceptable) WithPayload(payload *models.Error) *GetBlobSidecarsByBlockNumNotAcceptable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get blob sidecars by block num not acceptable response
+func (o *GetBlobSidecarsByBlockNumNotAcceptable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetBlobSidecarsByBlockNumNotAcceptable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(406)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+// GetBlobSidecarsByBlockNumTooManyRequestsCode is the HTTP code returned for type GetBlobSidecarsByBlockNumTooManyRequests
+const GetBlobSidecarsByBlockNumTooManyRequestsCode int = 429
+
+/*
+GetBlobSidecarsByBlockNumTooManyRequests the archive storage is rate limiting the service, retry after the Retry-After header
+
+swagger:response getBlobSidecarsByBlockNumTooManyRequests
+*/
+type GetBlobSidecarsByBlockNumTooManyRequests struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetBlobSidecarsByBlockNumTooManyRequests creates GetBlobSidecarsByBlockNumTooManyRequests with default headers values
+func NewGetBlobSidecarsByBlockNumTooManyRequests() *GetBlobSidecarsByBlockNumTooManyRequests {
+
+	return &GetBlobSidecarsByBlockNumTooManyRequests{}
+}
+
+// WithPayload adds the payload to the get blob sidecars by block num too many requests response
+func (o *GetBlobSidecarsByBlockNumTooManyRequests) WithPayload(payload *models.Error) *GetBlobSidecarsByBlockNumTooManyRequests {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get blob sidecars by block num too many requests response
+func (o *GetBlobSidecarsByBlockNumTooManyRequests) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetBlobSidecarsByBlockNumTooManyRequests) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(429)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
 // GetBlobSidecarsByBlockNumInternalServerErrorCode is the HTTP code returned for type GetBlobSidecarsByBlockNumInternalServerError
 const GetBlobSidecarsByBlockNumInternalServerErrorCode int = 500
 
@@ -192,3 +282,48 @@ func (o *GetBlobSidecarsByBlockNumInternalServerError) WriteResponse(rw http.Res
 		}
 	}
 }
+
+// GetBlobSidecarsByBlockNumServiceUnavailableCode is the HTTP code returned for type GetBlobSidecarsByBlockNumServiceUnavailable
+const GetBlobSidecarsByBlockNumServiceUnavailableCode int = 503
+
+/*
+GetBlobSidecarsByBlockNumServiceUnavailable a dependency is temporarily unavailable, retry later
+
+swagger:response getBlobSidecarsByBlockNumServiceUnavailable
+*/
+type GetBlobSidecarsByBlockNumServiceUnavailable struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetBlobSidecarsByBlockNumServiceUnavailable creates GetBlobSidecarsByBlockNumServiceUnavailable with default headers values
+func NewGetBlobSidecarsByBlockNumServiceUnavailable() *GetBlobSidecarsByBlockNumServiceUnavailable {
+
+	return &GetBlobSidecarsByBlockNumServiceUnavailable{}
+}
+
+// WithPayload adds the payload to the get blob sidecars by block num service unavailable response
+func (o *GetBlobSidecarsByBlockNumServiceUnavailable) WithPayload(payload *models.Error) *GetBlobSidecarsByBlockNumServiceUnavailable {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get blob sidecars by block num service unavailable response
+func (o *GetBlobSidecarsByBlockNumServiceUnavailable) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetBlobSidecarsByBlockNumServiceUnavailable) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(503)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package blob
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetBlobsByAddressHandlerFunc turns a function with the right signature into a get blobs by address handler
+type GetBlobsByAddressHandlerFunc func(GetBlobsByAddressParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetBlobsByAddressHandlerFunc) Handle(params GetBlobsByAddressParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetBlobsByAddressHandler interface for that can handle valid get blobs by address params
+type GetBlobsByAddressHandler interface {
+	Handle(GetBlobsByAddressParams) middleware.Responder
+}
+
+// NewGetBlobsByAddress creates a new http.Handler for the get blobs by address operation
+func NewGetBlobsByAddress(ctx *middleware.Context, handler GetBlobsByAddressHandler) *GetBlobsByAddress {
+	return &GetBlobsByAddress{Context: ctx, Handler: handler}
+}
+
+/*
+	GetBlobsByAddress swagger:route GET /blobhub/v1/addresses/{address}/blobs blob getBlobsByAddress
+
+Get the metadata of the blobs sent from or to an address, the latest first
+*/
+type GetBlobsByAddress struct {
+	Context *middleware.Context
+	Handler GetBlobsByAddressHandler
+}
+
+func (o *GetBlobsByAddress) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetBlobsByAddressParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
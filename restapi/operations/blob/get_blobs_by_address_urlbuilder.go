@@ -0,0 +1,130 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package blob
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+	"strings"
+
+	"github.com/go-openapi/swag"
+)
+
+// GetBlobsByAddressURL generates an URL for the get blobs by address operation
+type GetBlobsByAddressURL struct {
+	Address string
+
+	Page     *int64
+	PageSize *int64
+	Role     *string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetBlobsByAddressURL) WithBasePath(bp string) *GetBlobsByAddressURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetBlobsByAddressURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *GetBlobsByAddressURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/blobhub/v1/addresses/{address}/blobs"
+
+	address := o.Address
+	if address != "" {
+		_path = strings.Replace(_path, "{address}", address, -1)
+	} else {
+		return nil, errors.New("address is required on GetBlobsByAddressURL")
+	}
+
+	_basePath := o._basePath
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var pageQ string
+	if o.Page != nil {
+		pageQ = swag.FormatInt64(*o.Page)
+	}
+	if pageQ != "" {
+		qs.Set("page", pageQ)
+	}
+
+	var pageSizeQ string
+	if o.PageSize != nil {
+		pageSizeQ = swag.FormatInt64(*o.PageSize)
+	}
+	if pageSizeQ != "" {
+		qs.Set("page_size", pageSizeQ)
+	}
+
+	var roleQ string
+	if o.Role != nil {
+		roleQ = *o.Role
+	}
+	if roleQ != "" {
+		qs.Set("role", roleQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *GetBlobsByAddressURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *GetBlobsByAddressURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *GetBlobsByAddressURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on GetBlobsByAddressURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on GetBlobsByAddressURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *GetBlobsByAddressURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package blob
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetBlobsByTxHashHandlerFunc turns a function with the right signature into a get blobs by tx hash handler
+type GetBlobsByTxHashHandlerFunc func(GetBlobsByTxHashParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetBlobsByTxHashHandlerFunc) Handle(params GetBlobsByTxHashParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetBlobsByTxHashHandler interface for that can handle valid get blobs by tx hash params
+type GetBlobsByTxHashHandler interface {
+	Handle(GetBlobsByTxHashParams) middleware.Responder
+}
+
+// NewGetBlobsByTxHash creates a new http.Handler for the get blobs by tx hash operation
+func NewGetBlobsByTxHash(ctx *middleware.Context, handler GetBlobsByTxHashHandler) *GetBlobsByTxHash {
+	return &GetBlobsByTxHash{Context: ctx, Handler: handler}
+}
+
+/*
+	GetBlobsByTxHash swagger:route GET /blobhub/v1/txs/{tx_hash}/blobs blob getBlobsByTxHash
+
+Get blob sidecars by tx hash
+*/
+type GetBlobsByTxHash struct {
+	Context *middleware.Context
+	Handler GetBlobsByTxHashHandler
+}
+
+func (o *GetBlobsByTxHash) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetBlobsByTxHashParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
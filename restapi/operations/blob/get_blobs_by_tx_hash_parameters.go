@@ -0,0 +1,87 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package blob
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewGetBlobsByTxHashParams creates a new GetBlobsByTxHashParams object
+//
+// There are no default values defined in the spec.
+func NewGetBlobsByTxHashParams() GetBlobsByTxHashParams {
+
+	return GetBlobsByTxHashParams{}
+}
+
+// GetBlobsByTxHashParams contains all the bound params for the get blobs by tx hash operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters getBlobsByTxHash
+type GetBlobsByTxHashParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Hash of the blob tx with 0x prefix
+	  Required: true
+	  Min Length: 1
+	  In: path
+	*/
+	TxHash string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewGetBlobsByTxHashParams() beforehand.
+func (o *GetBlobsByTxHashParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rTxHash, rhkTxHash, _ := route.Params.GetOK("tx_hash")
+	if err := o.bindTxHash(rTxHash, rhkTxHash, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindTxHash binds and validates parameter TxHash from path.
+func (o *GetBlobsByTxHashParams) bindTxHash(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.TxHash = raw
+
+	if err := o.validateTxHash(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateTxHash carries on validations for parameter TxHash
+func (o *GetBlobsByTxHashParams) validateTxHash(formats strfmt.Registry) error {
+
+	if err := validate.MinLength("tx_hash", "path", o.TxHash, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
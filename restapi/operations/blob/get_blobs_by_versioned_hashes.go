@@ -0,0 +1,56 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package blob
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// GetBlobsByVersionedHashesHandlerFunc turns a function with the right signature into a get blobs by versioned hashes handler
+type GetBlobsByVersionedHashesHandlerFunc func(GetBlobsByVersionedHashesParams) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetBlobsByVersionedHashesHandlerFunc) Handle(params GetBlobsByVersionedHashesParams) middleware.Responder {
+	return fn(params)
+}
+
+// GetBlobsByVersionedHashesHandler interface for that can handle valid get blobs by versioned hashes params
+type GetBlobsByVersionedHashesHandler interface {
+	Handle(GetBlobsByVersionedHashesParams) middleware.Responder
+}
+
+// NewGetBlobsByVersionedHashes creates a new http.Handler for the get blobs by versioned hashes operation
+func NewGetBlobsByVersionedHashes(ctx *middleware.Context, handler GetBlobsByVersionedHashesHandler) *GetBlobsByVersionedHashes {
+	return &GetBlobsByVersionedHashes{Context: ctx, Handler: handler}
+}
+
+/*
+	GetBlobsByVersionedHashes swagger:route GET /blobhub/v1/blobs/{versioned_hash} blob getBlobsByVersionedHashes
+
+Get blob sidecars by versioned hashes
+*/
+type GetBlobsByVersionedHashes struct {
+	Context *middleware.Context
+	Handler GetBlobsByVersionedHashesHandler
+}
+
+func (o *GetBlobsByVersionedHashes) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetBlobsByVersionedHashesParams()
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -7,6 +7,7 @@ package operations
 
 import (
 	"fmt"
+	"io"
 	"net/http"
 	"strings"
 
@@ -19,6 +20,7 @@ import (
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
 
+	"github.com/bnb-chain/blob-hub/restapi/operations/beacon"
 	"github.com/bnb-chain/blob-hub/restapi/operations/blob"
 )
 
@@ -42,7 +44,11 @@ func NewBlobHubAPI(spec *loads.Document) *BlobHubAPI {
 
 		JSONConsumer: runtime.JSONConsumer(),
 
+		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
+		TextEventStreamProducer: runtime.ProducerFunc(func(w io.Writer, data interface{}) error {
+			return errors.NotImplemented("textEventStream producer has not yet been implemented")
+		}),
 
 		BlobGetBSCBlobSidecarsByBlockNumHandler: blob.GetBSCBlobSidecarsByBlockNumHandlerFunc(func(params blob.GetBSCBlobSidecarsByBlockNumParams) middleware.Responder {
 			return middleware.NotImplemented("operation blob.GetBSCBlobSidecarsByBlockNum has not yet been implemented")
@@ -50,6 +56,33 @@ func NewBlobHubAPI(spec *loads.Document) *BlobHubAPI {
 		BlobGetBlobSidecarsByBlockNumHandler: blob.GetBlobSidecarsByBlockNumHandlerFunc(func(params blob.GetBlobSidecarsByBlockNumParams) middleware.Responder {
 			return middleware.NotImplemented("operation blob.GetBlobSidecarsByBlockNum has not yet been implemented")
 		}),
+		BlobGetBlobsByAddressHandler: blob.GetBlobsByAddressHandlerFunc(func(params blob.GetBlobsByAddressParams) middleware.Responder {
+			return middleware.NotImplemented("operation blob.GetBlobsByAddress has not yet been implemented")
+		}),
+		BlobGetBlobsByTxHashHandler: blob.GetBlobsByTxHashHandlerFunc(func(params blob.GetBlobsByTxHashParams) middleware.Responder {
+			return middleware.NotImplemented("operation blob.GetBlobsByTxHash has not yet been implemented")
+		}),
+		BlobGetBlobsByVersionedHashesHandler: blob.GetBlobsByVersionedHashesHandlerFunc(func(params blob.GetBlobsByVersionedHashesParams) middleware.Responder {
+			return middleware.NotImplemented("operation blob.GetBlobsByVersionedHashes has not yet been implemented")
+		}),
+		BeaconGetBlockHeaderHandler: beacon.GetBlockHeaderHandlerFunc(func(params beacon.GetBlockHeaderParams) middleware.Responder {
+			return middleware.NotImplemented("operation beacon.GetBlockHeader has not yet been implemented")
+		}),
+		BeaconGetGenesisHandler: beacon.GetGenesisHandlerFunc(func(params beacon.GetGenesisParams) middleware.Responder {
+			return middleware.NotImplemented("operation beacon.GetGenesis has not yet been implemented")
+		}),
+		BeaconGetNodeVersionHandler: beacon.GetNodeVersionHandlerFunc(func(params beacon.GetNodeVersionParams) middleware.Responder {
+			return middleware.NotImplemented("operation beacon.GetNodeVersion has not yet been implemented")
+		}),
+		BeaconGetSpecHandler: beacon.GetSpecHandlerFunc(func(params beacon.GetSpecParams) middleware.Responder {
+			return middleware.NotImplemented("operation beacon.GetSpec has not yet been implemented")
+		}),
+		BlobStreamBlobsHandler: blob.StreamBlobsHandlerFunc(func(params blob.StreamBlobsParams) middleware.Responder {
+			return middleware.NotImplemented("operation blob.StreamBlobs has not yet been implemented")
+		}),
+		BlobSubscribeBlockEventsHandler: blob.SubscribeBlockEventsHandlerFunc(func(params blob.SubscribeBlockEventsParams) middleware.Responder {
+			return middleware.NotImplemented("operation blob.SubscribeBlockEvents has not yet been implemented")
+		}),
 	}
 }
 
@@ -82,14 +115,39 @@ type BlobHubAPI struct {
 	//   - application/json
 	JSONConsumer runtime.Consumer
 
+	// BinProducer registers a producer for the following mime types:
+	//   - application/octet-stream
+	BinProducer runtime.Producer
 	// JSONProducer registers a producer for the following mime types:
 	//   - application/json
+	//   - application/x-ndjson
 	JSONProducer runtime.Producer
+	// TextEventStreamProducer registers a producer for the following mime types:
+	//   - text/event-stream
+	TextEventStreamProducer runtime.Producer
 
 	// BlobGetBSCBlobSidecarsByBlockNumHandler sets the operation handler for the get b s c blob sidecars by block num operation
 	BlobGetBSCBlobSidecarsByBlockNumHandler blob.GetBSCBlobSidecarsByBlockNumHandler
 	// BlobGetBlobSidecarsByBlockNumHandler sets the operation handler for the get blob sidecars by block num operation
 	BlobGetBlobSidecarsByBlockNumHandler blob.GetBlobSidecarsByBlockNumHandler
+	// BlobGetBlobsByAddressHandler sets the operation handler for the get blobs by address operation
+	BlobGetBlobsByAddressHandler blob.GetBlobsByAddressHandler
+	// BlobGetBlobsByTxHashHandler sets the operation handler for the get blobs by tx hash operation
+	BlobGetBlobsByTxHashHandler blob.GetBlobsByTxHashHandler
+	// BlobGetBlobsByVersionedHashesHandler sets the operation handler for the get blobs by versioned hashes operation
+	BlobGetBlobsByVersionedHashesHandler blob.GetBlobsByVersionedHashesHandler
+	// BeaconGetBlockHeaderHandler sets the operation handler for the get block header operation
+	BeaconGetBlockHeaderHandler beacon.GetBlockHeaderHandler
+	// BeaconGetGenesisHandler sets the operation handler for the get genesis operation
+	BeaconGetGenesisHandler beacon.GetGenesisHandler
+	// BeaconGetNodeVersionHandler sets the operation handler for the get node version operation
+	BeaconGetNodeVersionHandler beacon.GetNodeVersionHandler
+	// BeaconGetSpecHandler sets the operation handler for the get spec operation
+	BeaconGetSpecHandler beacon.GetSpecHandler
+	// BlobStreamBlobsHandler sets the operation handler for the stream blobs operation
+	BlobStreamBlobsHandler blob.StreamBlobsHandler
+	// BlobSubscribeBlockEventsHandler sets the operation handler for the subscribe block events operation
+	BlobSubscribeBlockEventsHandler blob.SubscribeBlockEventsHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -163,9 +221,15 @@ func (o *BlobHubAPI) Validate() error {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
 
+	if o.BinProducer == nil {
+		unregistered = append(unregistered, "BinProducer")
+	}
 	if o.JSONProducer == nil {
 		unregistered = append(unregistered, "JSONProducer")
 	}
+	if o.TextEventStreamProducer == nil {
+		unregistered = append(unregistered, "TextEventStreamProducer")
+	}
 
 	if o.BlobGetBSCBlobSidecarsByBlockNumHandler == nil {
 		unregistered = append(unregistered, "blob.GetBSCBlobSidecarsByBlockNumHandler")
@@ -173,6 +237,33 @@ func (o *BlobHubAPI) Validate() error {
 	if o.BlobGetBlobSidecarsByBlockNumHandler == nil {
 		unregistered = append(unregistered, "blob.GetBlobSidecarsByBlockNumHandler")
 	}
+	if o.BlobGetBlobsByAddressHandler == nil {
+		unregistered = append(unregistered, "blob.GetBlobsByAddressHandler")
+	}
+	if o.BlobGetBlobsByTxHashHandler == nil {
+		unregistered = append(unregistered, "blob.GetBlobsByTxHashHandler")
+	}
+	if o.BlobGetBlobsByVersionedHashesHandler == nil {
+		unregistered = append(unregistered, "blob.GetBlobsByVersionedHashesHandler")
+	}
+	if o.BeaconGetBlockHeaderHandler == nil {
+		unregistered = append(unregistered, "beacon.GetBlockHeaderHandler")
+	}
+	if o.BeaconGetGenesisHandler == nil {
+		unregistered = append(unregistered, "beacon.GetGenesisHandler")
+	}
+	if o.BeaconGetNodeVersionHandler == nil {
+		unregistered = append(unregistered, "beacon.GetNodeVersionHandler")
+	}
+	if o.BeaconGetSpecHandler == nil {
+		unregistered = append(unregistered, "beacon.GetSpecHandler")
+	}
+	if o.BlobStreamBlobsHandler == nil {
+		unregistered = append(unregistered, "blob.StreamBlobsHandler")
+	}
+	if o.BlobSubscribeBlockEventsHandler == nil {
+		unregistered = append(unregistered, "blob.SubscribeBlockEventsHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -219,8 +310,14 @@ func (o *BlobHubAPI) ProducersFor(mediaTypes []string) map[string]runtime.Produc
 	result := make(map[string]runtime.Producer, len(mediaTypes))
 	for _, mt := range mediaTypes {
 		switch mt {
+		case "application/octet-stream":
+			result["application/octet-stream"] = o.BinProducer
 		case "application/json":
 			result["application/json"] = o.JSONProducer
+		case "application/x-ndjson":
+			result["application/x-ndjson"] = o.JSONProducer
+		case "text/event-stream":
+			result["text/event-stream"] = o.TextEventStreamProducer
 		}
 
 		if p, ok := o.customProducers[mt]; ok {
@@ -269,6 +366,42 @@ func (o *BlobHubAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/eth/v1/beacon/blob_sidecars/{block_id}"] = blob.NewGetBlobSidecarsByBlockNum(o.context, o.BlobGetBlobSidecarsByBlockNumHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/blobhub/v1/addresses/{address}/blobs"] = blob.NewGetBlobsByAddress(o.context, o.BlobGetBlobsByAddressHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/blobhub/v1/txs/{tx_hash}/blobs"] = blob.NewGetBlobsByTxHash(o.context, o.BlobGetBlobsByTxHashHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/blobhub/v1/blobs/{versioned_hash}"] = blob.NewGetBlobsByVersionedHashes(o.context, o.BlobGetBlobsByVersionedHashesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/eth/v1/beacon/headers/{block_id}"] = beacon.NewGetBlockHeader(o.context, o.BeaconGetBlockHeaderHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/eth/v1/beacon/genesis"] = beacon.NewGetGenesis(o.context, o.BeaconGetGenesisHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/eth/v1/node/version"] = beacon.NewGetNodeVersion(o.context, o.BeaconGetNodeVersionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/eth/v1/config/spec"] = beacon.NewGetSpec(o.context, o.BeaconGetSpecHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/blobhub/v1/blobs"] = blob.NewStreamBlobs(o.context, o.BlobStreamBlobsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/blobhub/v1/events"] = blob.NewSubscribeBlockEvents(o.context, o.BlobSubscribeBlockEventsHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP
@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/blob-hub/config"
+	"github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/models"
+	"github.com/bnb-chain/blob-hub/tracing"
+	"github.com/bnb-chain/blob-hub/util"
+)
+
+var (
+	ErrNetworkNotConfigured = errors.New("network is not configured")
+	ErrBeaconNotSupported   = errors.New("beacon block headers are only archived on ETH")
+)
+
+// Beacon serves the beacon API endpoints the rollup nodes call before they fetch blobs
+type Beacon interface {
+	GetGenesis() (*models.Genesis, error)
+	GetSpec() (map[string]string, error)
+	GetBlockHeaderBySlot(ctx context.Context, slot uint64) (*models.BlockHeader, error)
+	GetBlockHeaderByRoot(ctx context.Context, root string) (*models.BlockHeader, error)
+}
+
+type BeaconService struct {
+	blobDB db.BlobDao
+	cfg    *config.ServerConfig
+}
+
+func NewBeaconService(blobDB db.BlobDao, config *config.ServerConfig) Beacon {
+	return &BeaconService{
+		blobDB: blobDB,
+		cfg:    config,
+	}
+}
+
+func (b BeaconService) GetGenesis() (*models.Genesis, error) {
+	network := b.cfg.GetNetworkPreset()
+	if network == nil {
+		return nil, ErrNetworkNotConfigured
+	}
+	return &models.Genesis{
+		GenesisTime:           util.Uint64ToString(network.GenesisTime),
+		GenesisValidatorsRoot: network.GenesisValidatorsRoot,
+		GenesisForkVersion:    network.GenesisForkVersion,
+	}, nil
+}
+
+func (b BeaconService) GetSpec() (map[string]string, error) {
+	network := b.cfg.GetNetworkPreset()
+	if network == nil {
+		return nil, ErrNetworkNotConfigured
+	}
+	return network.Spec(), nil
+}
+
+// GetBlockHeaderBySlot returns the header of the block at the slot, gorm.ErrRecordNotFound is returned when the slot
+// is not archived or has no block
+func (b BeaconService) GetBlockHeaderBySlot(ctx context.Context, slot uint64) (header *models.BlockHeader, err error) {
+	ctx, span := tracing.StartSpan(ctx, "BeaconService.GetBlockHeaderBySlot")
+	span.SetAttributes(attribute.Int64("block_id", int64(slot)))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	if b.cfg.Chain != config.ETH {
+		return nil, ErrBeaconNotSupported
+	}
+	_, dbSpan := tracing.StartSpan(ctx, "db.GetBlock")
+	block, err := b.blobDB.GetBlock(slot)
+	tracing.EndSpan(dbSpan, err)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, blockNotFoundError(b.blobDB, slot)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toBlockHeader(block)
+}
+
+// GetBlockHeaderByRoot returns the header of the block of the root, gorm.ErrRecordNotFound is returned when the block
+// is not archived
+func (b BeaconService) GetBlockHeaderByRoot(ctx context.Context, root string) (header *models.BlockHeader, err error) {
+	ctx, span := tracing.StartSpan(ctx, "BeaconService.GetBlockHeaderByRoot")
+	span.SetAttributes(attribute.String("block_root", root))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	if b.cfg.Chain != config.ETH {
+		return nil, ErrBeaconNotSupported
+	}
+	_, dbSpan := tracing.StartSpan(ctx, "db.GetBlockByRoot")
+	block, err := b.blobDB.GetBlockByRoot(root)
+	tracing.EndSpan(dbSpan, err)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, notFoundError(ReasonBlockNotFound, "block %s%s is not archived", prefixHex, root)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toBlockHeader(block)
+}
+
+func toBlockHeader(block *db.Block) (*models.BlockHeader, error) {
+	// empty slots are recorded as blocks without root
+	if block.Root == "" {
+		return nil, notFoundError(ReasonBlockNotFound, "slot %d has no block", block.Slot)
+	}
+	return &models.BlockHeader{
+		Root:      fmt.Sprintf("%s%s", prefixHex, block.Root),
+		Canonical: true, // only finalized blocks are archived
+		Header: &models.SignedBeaconBlockHeader{
+			Message: &models.SignedBeaconBlockHeaderMessage{
+				BodyRoot:      fmt.Sprintf("%s%s", prefixHex, block.BodyRoot),
+				ParentRoot:    fmt.Sprintf("%s%s", prefixHex, block.ParentRoot),
+				StateRoot:     fmt.Sprintf("%s%s", prefixHex, block.StateRoot),
+				ProposerIndex: util.Uint64ToString(block.ProposerIndex),
+				Slot:          util.Uint64ToString(block.Slot),
+			},
+			Signature: fmt.Sprintf("%s%s", prefixHex, block.Signature),
+		},
+	}, nil
+}
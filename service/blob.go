@@ -1,118 +1,472 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/singleflight"
+	"gorm.io/gorm"
 
 	"github.com/bnb-chain/blob-hub/cache"
 	"github.com/bnb-chain/blob-hub/config"
 	"github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/external"
 	"github.com/bnb-chain/blob-hub/external/cmn"
+	"github.com/bnb-chain/blob-hub/metrics"
 	"github.com/bnb-chain/blob-hub/models"
+	"github.com/bnb-chain/blob-hub/tracing"
+	"github.com/bnb-chain/blob-hub/types"
 	"github.com/bnb-chain/blob-hub/util"
 )
 
 const prefixHex = "0x"
 
+// block identifiers resolved from the archive state rather than the chain
+const (
+	BlockIDHead      = "head"      // the latest archived block
+	BlockIDFinalized = "finalized" // the latest verified block
+	BlockIDGenesis   = "genesis"   // the first archived block
+)
+
+// MaxVersionedHashesPerRequest caps the batch of versioned hashes looked up at once
+const MaxVersionedHashesPerRequest = 64
+
+// bundleFetchConcurrency caps the blobs fetched at once from the bundle service for a request
+const bundleFetchConcurrency = 8
+
 type Blob interface {
-	GetBlobSidecarsByRoot(root string, indices []int64) ([]*models.Sidecar, error)
-	GetBlobSidecarsByBlockNumOrSlot(slot uint64, indices []int64) ([]*models.Sidecar, error)
+	GetBlobSidecarsByRoot(ctx context.Context, root string, indices []int64) ([]*models.Sidecar, error)
+	GetBlobSidecarsByBlockNumOrSlot(ctx context.Context, slot uint64, indices []int64) ([]*models.Sidecar, error)
+	ResolveBlockIdentifier(ctx context.Context, identifier string) (uint64, *int64, error)
+	ConsensusVersion(slot uint64) string
+	Chain() string
+	GetBlobsByVersionedHashes(ctx context.Context, hashes []string) ([]*models.VersionedBlob, error)
+	GetBlobsByTxHash(ctx context.Context, txHash string) ([]*models.VersionedBlob, error)
+	GetBlobsByAddress(ctx context.Context, address string, role db.AddressRole, page, pageSize int) ([]*models.BlobMeta, bool, error)
+	GetBlockNumByHash(ctx context.Context, blockHash string) (uint64, error)
+	GetBSCBlobTxSidecars(ctx context.Context, blockNum uint64, fullBlob bool) ([]*models.BSCBlobTxSidecar, error)
+	GetBSCBlobTxSidecarByTxHash(ctx context.Context, txHash string, fullBlob bool) (*models.BSCBlobTxSidecar, error)
+	StreamBlobs(ctx context.Context, from, to uint64, cursor *StreamCursor, fn func(*models.StreamedBlob) error) error
+	GetBlockMeta(ctx context.Context, blockNumOrSlot uint64) (*db.Block, error)
+	GetSlotByRoot(ctx context.Context, root string) (uint64, error)
+	GetBundleMeta(ctx context.Context, bundleName string) (*db.Bundle, error)
 }
 
 type BlobService struct {
 	blobDB       db.BlobDao
 	bundleClient *cmn.BundleClient
 	cacheService cache.Cache
+	chainClient  external.IClient // chainClient serves the blocks not archived yet, nil unless the live chain is enabled
 	cfg          *config.ServerConfig
+	flight       *singleflight.Group // coalesces the concurrent lookups of a slot or a blob
 }
 
-func NewBlobService(blobDB db.BlobDao, bundleClient *cmn.BundleClient, cache cache.Cache, config *config.ServerConfig) Blob {
+func NewBlobService(blobDB db.BlobDao, bundleClient *cmn.BundleClient, cache cache.Cache, chainClient external.IClient, config *config.ServerConfig) Blob {
 	return &BlobService{
 		blobDB:       blobDB,
 		bundleClient: bundleClient,
 		cacheService: cache,
+		chainClient:  chainClient,
 		cfg:          config,
+		flight:       &singleflight.Group{},
 	}
 }
 
-func (b BlobService) GetBlobSidecarsByBlockNumOrSlot(blockNumOrSlot uint64, indices []int64) ([]*models.Sidecar, error) {
-	var err error
-	blobs, found := b.cacheService.Get(util.Uint64ToString(blockNumOrSlot))
-	if found {
-		blobsFound := blobs.([]*models.Sidecar)
-		if len(indices) != 0 {
-			blobReturn := make([]*models.Sidecar, 0)
-			for _, idx := range indices {
-				if int(idx) >= len(blobsFound) {
-					return nil, fmt.Errorf("index %d out of bound, only %d blob at block %d", idx, len(blobsFound), blockNumOrSlot)
-				}
-				blobReturn = append(blobReturn, blobsFound[idx])
-			}
-			return blobReturn, nil
-		}
-		return blobsFound, nil
+func (b BlobService) GetBlobSidecarsByBlockNumOrSlot(ctx context.Context, blockNumOrSlot uint64, indices []int64) (sideCars []*models.Sidecar, err error) {
+	ctx, span := tracing.StartSpan(ctx, "BlobService.GetBlobSidecarsByBlockNumOrSlot")
+	span.SetAttributes(attribute.Int64("block_id", int64(blockNumOrSlot)))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	result, err := b.coalesce(ctx, fmt.Sprintf("slot:%d:%v", blockNumOrSlot, indices), func(ctx context.Context) (interface{}, error) {
+		return b.getBlobSidecars(ctx, blockNumOrSlot, indices)
+	})
+	if err != nil {
+		return nil, err
 	}
+	return result.([]*models.Sidecar), nil
+}
 
+func (b BlobService) getBlobSidecars(ctx context.Context, blockNumOrSlot uint64, indices []int64) ([]*models.Sidecar, error) {
+	_, dbSpan := tracing.StartSpan(ctx, "db.GetBlock")
 	block, err := b.blobDB.GetBlock(blockNumOrSlot)
+	tracing.EndSpan(dbSpan, err)
+	if errors.Is(err, gorm.ErrRecordNotFound) && b.chainClient != nil {
+		sideCars, chainErr := b.getUnarchivedBlobSidecars(ctx, blockNumOrSlot, indices)
+		if errors.Is(chainErr, gorm.ErrRecordNotFound) {
+			return nil, blockNotFoundError(b.blobDB, blockNumOrSlot)
+		}
+		return sideCars, chainErr
+	}
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, blockNotFoundError(b.blobDB, blockNumOrSlot)
+	}
 	if err != nil {
 		return nil, err
 	}
 
 	var blobMetas []*db.Blob
 	if len(indices) == 0 {
+		_, dbSpan = tracing.StartSpan(ctx, "db.GetBlobByBlockID")
 		blobMetas, err = b.blobDB.GetBlobByBlockID(blockNumOrSlot)
+		tracing.EndSpan(dbSpan, err)
 		if err != nil {
 			return nil, err
 		}
 	} else {
+		_, dbSpan = tracing.StartSpan(ctx, "db.GetBlobByBlockIDAndIndices")
 		blobMetas, err = b.blobDB.GetBlobByBlockIDAndIndices(blockNumOrSlot, indices)
+		tracing.EndSpan(dbSpan, err)
 		if err != nil {
 			return nil, err
 		}
 	}
 
-	sideCars := make([]*models.Sidecar, 0)
+	sideCars := make([]*models.Sidecar, len(blobMetas))
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(bundleFetchConcurrency)
+	for i, meta := range blobMetas {
+		i, meta := i, meta
+		g.Go(func() error {
+			blob, err := b.getBlob(gCtx, block, meta)
+			if err != nil {
+				return err
+			}
+			sideCars[i] = b.toSidecar(block, meta, blob)
+			return nil
+		})
+	}
+	if err = g.Wait(); err != nil {
+		return nil, err
+	}
+	return sideCars, nil
+}
+
+func (b BlobService) GetBlobSidecarsByRoot(ctx context.Context, root string, indices []int64) (sideCars []*models.Sidecar, err error) {
+	ctx, span := tracing.StartSpan(ctx, "BlobService.GetBlobSidecarsByRoot")
+	span.SetAttributes(attribute.String("block_root", root))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	_, dbSpan := tracing.StartSpan(ctx, "db.GetBlockByRoot")
+	block, err := b.blobDB.GetBlockByRoot(root)
+	tracing.EndSpan(dbSpan, err)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, notFoundError(ReasonBlockNotFound, "block %s%s is not archived", prefixHex, root)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return b.GetBlobSidecarsByBlockNumOrSlot(ctx, block.Slot, indices)
+}
+
+// GetBlobsByVersionedHashes returns the archived blobs of the versioned hashes in slot and index order, the hashes not
+// archived are absent
+func (b BlobService) GetBlobsByVersionedHashes(ctx context.Context, hashes []string) (blobs []*models.VersionedBlob, err error) {
+	ctx, span := tracing.StartSpan(ctx, "BlobService.GetBlobsByVersionedHashes")
+	span.SetAttributes(attribute.Int("versioned_hashes", len(hashes)))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	_, dbSpan := tracing.StartSpan(ctx, "db.GetBlobsByVersionedHashes")
+	blobMetas, err := b.blobDB.GetBlobsByVersionedHashes(hashes)
+	tracing.EndSpan(dbSpan, err)
+	if err != nil {
+		return nil, err
+	}
+
+	return b.toVersionedBlobs(ctx, blobMetas)
+}
+
+// GetBlobsByTxHash returns the archived blobs of the tx in index order
+func (b BlobService) GetBlobsByTxHash(ctx context.Context, txHash string) (blobs []*models.VersionedBlob, err error) {
+	ctx, span := tracing.StartSpan(ctx, "BlobService.GetBlobsByTxHash")
+	span.SetAttributes(attribute.String("tx_hash", txHash))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	_, dbSpan := tracing.StartSpan(ctx, "db.GetBlobsByTxHash")
+	blobMetas, err := b.blobDB.GetBlobsByTxHash(txHash)
+	tracing.EndSpan(dbSpan, err)
+	if err != nil {
+		return nil, err
+	}
+	return b.toVersionedBlobs(ctx, blobMetas)
+}
+
+// GetBlobsByAddress returns a page of the metadata of the blobs sent from or to the address, the latest first. hasMore
+// tells whether there is a next page.
+func (b BlobService) GetBlobsByAddress(ctx context.Context, address string, role db.AddressRole, page, pageSize int) (blobs []*models.BlobMeta, hasMore bool, err error) {
+	ctx, span := tracing.StartSpan(ctx, "BlobService.GetBlobsByAddress")
+	span.SetAttributes(attribute.String("address", address), attribute.String("role", string(role)), attribute.Int("page", page))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	// one more blob is queried to tell whether there is a next page
+	_, dbSpan := tracing.StartSpan(ctx, "db.GetBlobsByAddress")
+	blobMetas, err := b.blobDB.GetBlobsByAddress(address, role, (page-1)*pageSize, pageSize+1)
+	tracing.EndSpan(dbSpan, err)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(blobMetas) > pageSize {
+		blobMetas = blobMetas[:pageSize]
+		hasMore = true
+	}
+	blobs = make([]*models.BlobMeta, 0, len(blobMetas))
 	for _, meta := range blobMetas {
-		bundleObject, err := b.bundleClient.GetObject(b.cfg.BucketName, block.BundleName, meta.Name)
+		blobs = append(blobs, toBlobMeta(meta))
+	}
+	return blobs, hasMore, nil
+}
+
+func toBlobMeta(meta *db.Blob) *models.BlobMeta {
+	return &models.BlobMeta{
+		VersionedHash: meta.VersionedHash,
+		Slot:          util.Uint64ToString(meta.Slot),
+		Index:         util.Int64ToString(int64(meta.Idx)),
+		TxHash:        fmt.Sprintf("%s%s", prefixHex, meta.TxHash),
+		TxIndex:       int64(meta.TxIndex),
+		From:          meta.FromAddr,
+		To:            meta.ToAddr,
+		KzgCommitment: meta.KzgCommitment,
+	}
+}
+
+// toVersionedBlobs fetches the sidecars of the blobs, fetching the sidecars of a block at once
+func (b BlobService) toVersionedBlobs(ctx context.Context, blobMetas []*db.Blob) ([]*models.VersionedBlob, error) {
+	slots := make([]uint64, 0)
+	indices := make(map[uint64][]int64)
+	for _, meta := range blobMetas {
+		if _, ok := indices[meta.Slot]; !ok {
+			slots = append(slots, meta.Slot)
+		}
+		indices[meta.Slot] = append(indices[meta.Slot], int64(meta.Idx))
+	}
+	sidecars := make(map[uint64]map[string]*models.Sidecar, len(slots))
+	for _, slot := range slots {
+		slotSidecars, err := b.GetBlobSidecarsByBlockNumOrSlot(ctx, slot, indices[slot])
 		if err != nil {
 			return nil, err
 		}
-		var header *models.SidecarSignedBlockHeader
-		if b.cfg.Chain == config.ETH {
-			header = &models.SidecarSignedBlockHeader{
-				Message: &models.SidecarSignedBlockHeaderMessage{
-					BodyRoot:      fmt.Sprintf("%s%s", prefixHex, block.BodyRoot),
-					ParentRoot:    fmt.Sprintf("%s%s", prefixHex, block.ParentRoot),
-					StateRoot:     fmt.Sprintf("%s%s", prefixHex, block.StateRoot),
-					ProposerIndex: util.Uint64ToString(block.ProposerIndex),
-					Slot:          util.Uint64ToString(block.Slot),
-				},
-				Signature: fmt.Sprintf("%s%s", prefixHex, block.Signature),
-			}
+		sidecars[slot] = make(map[string]*models.Sidecar, len(slotSidecars))
+		for _, sidecar := range slotSidecars {
+			sidecars[slot][sidecar.Index] = sidecar
 		}
-		sideCars = append(sideCars,
-			&models.Sidecar{
-				Blob:                        bundleObject,
-				Index:                       util.Int64ToString(int64(meta.Idx)),
-				KzgCommitmentInclusionProof: util.SplitByComma(meta.CommitmentInclusionProof),
-				KzgCommitment:               meta.KzgCommitment,
-				KzgProof:                    meta.KzgProof,
-				SignedBlockHeader:           header,
-				TxIndex:                     int64(meta.TxIndex),
-				TxHash:                      meta.TxHash,
-			})
-	}
-
-	// cache all blobs at a specified slot
-	if len(indices) == 0 {
-		b.cacheService.Set(util.Uint64ToString(blockNumOrSlot), sideCars)
 	}
-	return sideCars, nil
+
+	blobs := make([]*models.VersionedBlob, 0, len(blobMetas))
+	for _, meta := range blobMetas {
+		sidecar, ok := sidecars[meta.Slot][util.Int64ToString(int64(meta.Idx))]
+		if !ok {
+			continue
+		}
+		blobs = append(blobs, &models.VersionedBlob{
+			VersionedHash: meta.VersionedHash,
+			Slot:          util.Uint64ToString(meta.Slot),
+			TxHash:        fmt.Sprintf("%s%s", prefixHex, meta.TxHash),
+			Sidecar:       sidecar,
+		})
+	}
+	return blobs, nil
 }
 
-func (b BlobService) GetBlobSidecarsByRoot(root string, indices []int64) ([]*models.Sidecar, error) {
-	block, err := b.blobDB.GetBlockByRoot(root)
+// ParseVersionedHashes validates the versioned hashes of a request, and returns them deduplicated in the lower case hex
+// stored in the DB
+func ParseVersionedHashes(hashes []string) ([]string, error) {
+	if len(hashes) == 0 {
+		return nil, fmt.Errorf("no versioned hash")
+	}
+	if len(hashes) > MaxVersionedHashesPerRequest {
+		return nil, fmt.Errorf("too many versioned hashes, the maximum is %d", MaxVersionedHashesPerRequest)
+	}
+	parsed := make([]string, 0, len(hashes))
+	seen := make(map[string]struct{}, len(hashes))
+	for _, hash := range hashes {
+		hashBz, err := hexutil.Decode(hash)
+		if err != nil {
+			return nil, fmt.Errorf("invalid versioned hash %s, err=%s", hash, err.Error())
+		}
+		if len(hashBz) != types.VersionedHashLength {
+			return nil, fmt.Errorf("invalid versioned hash of length %d", len(hashBz))
+		}
+		normalized := hexutil.Encode(hashBz)
+		if _, ok := seen[normalized]; ok {
+			continue
+		}
+		seen[normalized] = struct{}{}
+		parsed = append(parsed, normalized)
+	}
+	return parsed, nil
+}
+
+// Chain returns the configured chain, ETH or BSC
+func (b BlobService) Chain() string {
+	return b.cfg.Chain
+}
+
+// ConsensusVersion returns the fork name of the slot for the Eth-Consensus-Version header, it is empty for BSC
+func (b BlobService) ConsensusVersion(slot uint64) string {
+	if b.cfg.Chain != config.ETH {
+		return ""
+	}
+	network := b.cfg.GetNetworkPreset()
+	if network == nil {
+		return config.DefaultConsensusVersion
+	}
+	return network.ConsensusVersion(slot)
+}
+
+// ResolveBlockIdentifier returns the block number or slot of 'head', 'finalized' and 'genesis', and the number of slots
+// the latest archived block lags the chain head by when the network is configured. On ETH the identifiers resolve to
+// blocks, the empty slots recorded without root are skipped. gorm.ErrRecordNotFound is returned when no block matches
+// the identifier yet.
+func (b BlobService) ResolveBlockIdentifier(ctx context.Context, identifier string) (blockNumOrSlot uint64, archiveLag *int64, err error) {
+	ctx, span := tracing.StartSpan(ctx, "BlobService.ResolveBlockIdentifier")
+	span.SetAttributes(attribute.String("block_identifier", identifier))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	_, dbSpan := tracing.StartSpan(ctx, "db.GetLatestProcessedBlock")
+	head, err := b.blobDB.GetLatestProcessedBlock()
+	tracing.EndSpan(dbSpan, err)
 	if err != nil {
-		return nil, err
+		return 0, nil, err
+	}
+	var block *db.Block
+	withRoot := b.cfg.Chain == config.ETH
+	switch {
+	case identifier == BlockIDHead && withRoot:
+		_, dbSpan = tracing.StartSpan(ctx, "db.GetLatestBlockWithRoot")
+		block, err = b.blobDB.GetLatestBlockWithRoot()
+		tracing.EndSpan(dbSpan, err)
+	case identifier == BlockIDHead:
+		block = head
+	case identifier == BlockIDFinalized && withRoot:
+		_, dbSpan = tracing.StartSpan(ctx, "db.GetLatestVerifiedBlockWithRoot")
+		block, err = b.blobDB.GetLatestVerifiedBlockWithRoot()
+		tracing.EndSpan(dbSpan, err)
+	case identifier == BlockIDFinalized:
+		_, dbSpan = tracing.StartSpan(ctx, "db.GetLatestVerifiedBlock")
+		block, err = b.blobDB.GetLatestVerifiedBlock()
+		tracing.EndSpan(dbSpan, err)
+	case identifier == BlockIDGenesis && withRoot:
+		_, dbSpan = tracing.StartSpan(ctx, "db.GetEarliestBlockWithRoot")
+		block, err = b.blobDB.GetEarliestBlockWithRoot()
+		tracing.EndSpan(dbSpan, err)
+	case identifier == BlockIDGenesis:
+		_, dbSpan = tracing.StartSpan(ctx, "db.GetEarliestBlock")
+		block, err = b.blobDB.GetEarliestBlock()
+		tracing.EndSpan(dbSpan, err)
+	default:
+		return 0, nil, NewError(ReasonInvalidArgument, fmt.Errorf("unknown block identifier %s", identifier))
 	}
-	return b.GetBlobSidecarsByBlockNumOrSlot(block.Slot, indices)
+	if err != nil {
+		return 0, nil, err
+	}
+	// the queries return an empty block when none is found
+	if block.Id == 0 {
+		return 0, nil, notFoundError(ReasonNotArchivedYet, "no block archived for %s yet", identifier)
+	}
+	if network := b.cfg.GetNetworkPreset(); network != nil {
+		lag := int64(network.CurrentSlot(time.Now())) - int64(head.Slot)
+		if lag < 0 {
+			lag = 0
+		}
+		archiveLag = &lag
+	}
+	return block.Slot, archiveLag, nil
+}
+
+// toSidecar builds the sidecar of a blob from its block, its metadata and its bundle object
+func (b BlobService) toSidecar(block *db.Block, meta *db.Blob, bundleObject string) *models.Sidecar {
+	var header *models.SidecarSignedBlockHeader
+	if b.cfg.Chain == config.ETH {
+		header = &models.SidecarSignedBlockHeader{
+			Message: &models.SidecarSignedBlockHeaderMessage{
+				BodyRoot:      fmt.Sprintf("%s%s", prefixHex, block.BodyRoot),
+				ParentRoot:    fmt.Sprintf("%s%s", prefixHex, block.ParentRoot),
+				StateRoot:     fmt.Sprintf("%s%s", prefixHex, block.StateRoot),
+				ProposerIndex: util.Uint64ToString(block.ProposerIndex),
+				Slot:          util.Uint64ToString(block.Slot),
+			},
+			Signature: fmt.Sprintf("%s%s", prefixHex, block.Signature),
+		}
+	}
+	return &models.Sidecar{
+		Blob:                        bundleObject,
+		Index:                       util.Int64ToString(int64(meta.Idx)),
+		KzgCommitmentInclusionProof: util.SplitByComma(meta.CommitmentInclusionProof),
+		KzgCommitment:               meta.KzgCommitment,
+		KzgProof:                    meta.KzgProof,
+		SignedBlockHeader:           header,
+		TxIndex:                     int64(meta.TxIndex),
+		TxHash:                      meta.TxHash,
+	}
+}
+
+// getBlob returns the blob from the cache, or reads it from the bundle service and caches it. The blobs of the verified
+// blocks are immutable and cached without expiry, the others only briefly as they may still be repaired.
+func (b BlobService) getBlob(ctx context.Context, block *db.Block, meta *db.Blob) (string, error) {
+	key := fmt.Sprintf("%d:%d", meta.Slot, meta.Idx)
+	_, cacheSpan := tracing.StartSpan(ctx, "cache.Get")
+	blob, found := b.cacheService.Get(key)
+	cacheSpan.SetAttributes(attribute.Bool("cache.hit", found))
+	tracing.EndSpan(cacheSpan, nil)
+	if found {
+		metrics.CacheRequestCounter.WithLabelValues(metrics.CacheHit).Inc()
+		return blob, nil
+	}
+	metrics.CacheRequestCounter.WithLabelValues(metrics.CacheMiss).Inc()
+
+	result, err := b.coalesce(ctx, "blob:"+key, func(ctx context.Context) (interface{}, error) {
+		fetchStart := time.Now()
+		blob, err := b.getBundleObject(ctx, block.BundleName, meta.Name)
+		if err != nil {
+			return "", err
+		}
+		metrics.BundleFetchDurationHistogram.Observe(time.Since(fetchStart).Seconds())
+		var ttl time.Duration
+		if block.Status != db.Verified {
+			ttl = b.cfg.CacheConfig.GetUnverifiedTTL()
+		}
+		b.cacheService.Set(key, blob, ttl)
+		return blob, nil
+	})
+	if err != nil {
+		return "", err
+	}
+	return result.(string), nil
+}
+
+// coalesce runs fn once for the concurrent calls of a key. fn runs with the context of the first caller, without its
+// cancellation so the other callers still get the result, but with its deadline. Each caller stops waiting at its own
+// deadline, and retries when the shared call ran out of the time of another caller.
+func (b BlobService) coalesce(ctx context.Context, key string, fn func(ctx context.Context) (interface{}, error)) (interface{}, error) {
+	for {
+		ch := b.flight.DoChan(key, func() (interface{}, error) {
+			fnCtx := context.WithoutCancel(ctx)
+			if deadline, ok := ctx.Deadline(); ok {
+				var cancel context.CancelFunc
+				fnCtx, cancel = context.WithDeadline(fnCtx, deadline)
+				defer cancel()
+			}
+			return fn(fnCtx)
+		})
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case res := <-ch:
+			if res.Shared && errors.Is(res.Err, context.DeadlineExceeded) && ctx.Err() == nil {
+				continue
+			}
+			return res.Val, res.Err
+		}
+	}
+}
+
+func (b BlobService) getBundleObject(ctx context.Context, bundleName, objectName string) (object string, err error) {
+	ctx, span := tracing.StartSpan(ctx, "BundleClient.GetObject")
+	span.SetAttributes(attribute.String("bundle_name", bundleName), attribute.String("object_name", objectName))
+	defer func() { tracing.EndSpan(span, err) }()
+	return b.bundleClient.GetObject(ctx, b.cfg.BucketName, bundleName, objectName)
 }
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/bnb-chain/blob-hub/config"
+	"github.com/bnb-chain/blob-hub/db"
+)
+
+func TestParseVersionedHashes(t *testing.T) {
+	hash := "0x01" + strings.Repeat("ab", 31)
+	other := "0x01" + strings.Repeat("cd", 31)
+	tooMany := make([]string, MaxVersionedHashesPerRequest+1)
+	for i := range tooMany {
+		tooMany[i] = hash
+	}
+	tests := []struct {
+		name    string
+		hashes  []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "single", hashes: []string{hash}, want: []string{hash}},
+		{name: "order kept", hashes: []string{other, hash}, want: []string{other, hash}},
+		{name: "upper case normalized", hashes: []string{"0x01" + strings.Repeat("AB", 31)}, want: []string{hash}},
+		{name: "duplicates dropped", hashes: []string{hash, other, "0x01" + strings.Repeat("AB", 31)}, want: []string{hash, other}},
+		{name: "none", hashes: nil, wantErr: true},
+		{name: "too many", hashes: tooMany, wantErr: true},
+		{name: "no prefix", hashes: []string{strings.TrimPrefix(hash, "0x")}, wantErr: true},
+		{name: "not hex", hashes: []string{"0x01" + strings.Repeat("zz", 31)}, wantErr: true},
+		{name: "too short", hashes: []string{hash[:len(hash)-2]}, wantErr: true},
+		{name: "too long", hashes: []string{hash + "00"}, wantErr: true},
+		{name: "one invalid among valid", hashes: []string{hash, "0x"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseVersionedHashes(tt.hashes)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseVersionedHashes() = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseVersionedHashes() failed, err=%s", err.Error())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseVersionedHashes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// newTestBlobDB opens a SQLite DB migrated to the latest schema version
+func newTestBlobDB(t *testing.T) db.BlobDao {
+	t.Helper()
+	gormDB, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "blob-hub.db")), &gorm.Config{
+		Logger:         logger.Default.LogMode(logger.Silent),
+		TranslateError: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = db.MigrateUp(gormDB, db.LatestSchemaVersion()); err != nil {
+		t.Fatal(err)
+	}
+	return db.NewBlobSvcDB(gormDB)
+}
+
+func TestResolveBlockIdentifier(t *testing.T) {
+	tests := []struct {
+		name       string
+		chain      string
+		blocks     []*db.Block
+		identifier string
+		want       uint64
+		wantErr    error
+	}{
+		{
+			name:  "head skips a trailing empty slot",
+			chain: config.ETH,
+			blocks: []*db.Block{
+				{Slot: 1}, {Slot: 2, Root: "root2", Status: db.Verified}, {Slot: 3, Root: "root3"}, {Slot: 4},
+			},
+			identifier: BlockIDHead,
+			want:       3,
+		},
+		{
+			name:  "genesis skips a leading empty slot",
+			chain: config.ETH,
+			blocks: []*db.Block{
+				{Slot: 1}, {Slot: 2, Root: "root2", Status: db.Verified}, {Slot: 3, Root: "root3"}, {Slot: 4},
+			},
+			identifier: BlockIDGenesis,
+			want:       2,
+		},
+		{
+			name:  "finalized skips a verified empty slot",
+			chain: config.ETH,
+			blocks: []*db.Block{
+				{Slot: 2, Root: "root2", Status: db.Verified}, {Slot: 3, Status: db.Verified}, {Slot: 4, Root: "root4"},
+			},
+			identifier: BlockIDFinalized,
+			want:       2,
+		},
+		{
+			name:       "only empty slots",
+			chain:      config.ETH,
+			blocks:     []*db.Block{{Slot: 1}, {Slot: 2}},
+			identifier: BlockIDHead,
+			wantErr:    gorm.ErrRecordNotFound,
+		},
+		{
+			name:       "BSC blocks have no root",
+			chain:      config.BSC,
+			blocks:     []*db.Block{{Slot: 1, BlockHash: "hash1"}, {Slot: 2, BlockHash: "hash2"}},
+			identifier: BlockIDHead,
+			want:       2,
+		},
+		{
+			name:       "nothing archived",
+			chain:      config.ETH,
+			identifier: BlockIDGenesis,
+			wantErr:    gorm.ErrRecordNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			blobDB := newTestBlobDB(t)
+			for _, block := range tt.blocks {
+				if err := blobDB.SaveBlockAndBlob(block, nil); err != nil {
+					t.Fatal(err)
+				}
+			}
+			svc := NewBlobService(blobDB, nil, nil, nil, &config.ServerConfig{Chain: tt.chain})
+			got, _, err := svc.ResolveBlockIdentifier(context.Background(), tt.identifier)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("ResolveBlockIdentifier() = %d, %v, want %v", got, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveBlockIdentifier() failed, err=%s", err.Error())
+			}
+			if got != tt.want {
+				t.Errorf("ResolveBlockIdentifier() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
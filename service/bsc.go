@@ -0,0 +1,167 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"go.opentelemetry.io/otel/attribute"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/blob-hub/models"
+	"github.com/bnb-chain/blob-hub/tracing"
+	"github.com/bnb-chain/blob-hub/util"
+)
+
+// truncatedBlobLength is the length of a blob returned without fullBlob, the 0x prefix and its first 32 bytes as BSC
+// nodes do
+const truncatedBlobLength = 2 + 2*32
+
+// GetBlockNumByHash returns the number of the block of the hash, gorm.ErrRecordNotFound is returned when the block is
+// not archived. The hashes of the blocks archived before schema version 5 are backfilled by the syncer, the hashes not
+// recorded are looked up on the chain in the meantime when the live chain is enabled.
+func (b BlobService) GetBlockNumByHash(ctx context.Context, blockHash string) (blockNum uint64, err error) {
+	ctx, span := tracing.StartSpan(ctx, "BlobService.GetBlockNumByHash")
+	span.SetAttributes(attribute.String("block_hash", blockHash))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	block, err := b.blobDB.GetBlockByHash(blockHash)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		if b.chainClient != nil {
+			return b.getBlockNumByHashFromChain(ctx, blockHash)
+		}
+		return 0, notFoundError(ReasonBlockNotFound, "block %s%s is not archived", prefixHex, blockHash)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return block.Slot, nil
+}
+
+// getBlockNumByHashFromChain returns the number of the canonical block of the hash on the chain, a block replaced by a
+// reorg is not found
+func (b BlobService) getBlockNumByHashFromChain(ctx context.Context, blockHash string) (uint64, error) {
+	ctx, span := tracing.StartSpan(ctx, "ChainClient.GetBlockHeaderByHash")
+	header, err := b.chainClient.GetBlockHeaderByHash(ctx, common.HexToHash(blockHash))
+	tracing.EndSpan(span, err)
+	if errors.Is(err, ethereum.NotFound) {
+		return 0, notFoundError(ReasonBlockNotFound, "block %s%s is not found", prefixHex, blockHash)
+	}
+	if err != nil {
+		return 0, err
+	}
+	blockNum := header.Number.Uint64()
+	canonicalHash, err := b.getBlockHash(ctx, blockNum)
+	if err != nil {
+		return 0, err
+	}
+	if canonicalHash != blockHash {
+		return 0, notFoundError(ReasonBlockNotFound, "block %s%s is not canonical", prefixHex, blockHash)
+	}
+	return blockNum, nil
+}
+
+// getBlockHash returns the hash of the canonical block of the number on the chain, without 0x as in DB
+func (b BlobService) getBlockHash(ctx context.Context, blockNum uint64) (string, error) {
+	ctx, span := tracing.StartSpan(ctx, "ChainClient.GetBlockHeader")
+	header, err := b.chainClient.GetBlockHeader(ctx, blockNum)
+	tracing.EndSpan(span, err)
+	if err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(header.Hash().Bytes()), nil
+}
+
+// GetBSCBlobTxSidecars returns the blob sidecars of the block grouped by tx in tx index order, the blobs are cut to
+// their first 32 bytes unless fullBlob. gorm.ErrRecordNotFound is returned when the block is not archived.
+func (b BlobService) GetBSCBlobTxSidecars(ctx context.Context, blockNum uint64, fullBlob bool) (txSidecars []*models.BSCBlobTxSidecar, err error) {
+	ctx, span := tracing.StartSpan(ctx, "BlobService.GetBSCBlobTxSidecars")
+	span.SetAttributes(attribute.Int64("block_id", int64(blockNum)))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	_, dbSpan := tracing.StartSpan(ctx, "db.GetBlock")
+	block, err := b.blobDB.GetBlock(blockNum)
+	tracing.EndSpan(dbSpan, err)
+	// the blocks not archived yet are served from the chain when the live chain is enabled
+	if err != nil && !(errors.Is(err, gorm.ErrRecordNotFound) && b.chainClient != nil) {
+		return nil, err
+	}
+	sidecars, err := b.GetBlobSidecarsByBlockNumOrSlot(ctx, blockNum, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	blockHash := ""
+	if block != nil && block.BlockHash != "" {
+		blockHash = fmt.Sprintf("%s%s", prefixHex, block.BlockHash)
+	} else if b.chainClient != nil {
+		// the block is not archived yet, or archived before its hash was recorded
+		hash, err := b.getBlockHash(ctx, blockNum)
+		if err != nil {
+			return nil, err
+		}
+		blockHash = fmt.Sprintf("%s%s", prefixHex, hash)
+	}
+	// the sidecars are in blob index order, which keeps the blobs of a tx in order
+	txSidecars = make([]*models.BSCBlobTxSidecar, 0)
+	txIndices := make(map[string]int64)
+	byTxHash := make(map[string]*models.BSCBlobTxSidecar)
+	for _, sidecar := range sidecars {
+		txSidecar, ok := byTxHash[sidecar.TxHash]
+		if !ok {
+			txSidecar = &models.BSCBlobTxSidecar{
+				BlobSidecar: &models.BSCBlobSidecar{},
+				BlockHash:   blockHash,
+				BlockNumber: util.Int64ToHex(int64(blockNum)),
+				TxHash:      fmt.Sprintf("%s%s", prefixHex, sidecar.TxHash),
+				TxIndex:     util.Int64ToHex(sidecar.TxIndex),
+			}
+			byTxHash[sidecar.TxHash] = txSidecar
+			txIndices[txSidecar.TxHash] = sidecar.TxIndex
+			txSidecars = append(txSidecars, txSidecar)
+		}
+		blob := sidecar.Blob
+		if !fullBlob && len(blob) > truncatedBlobLength {
+			blob = blob[:truncatedBlobLength]
+		}
+		txSidecar.BlobSidecar.Blobs = append(txSidecar.BlobSidecar.Blobs, blob)
+		txSidecar.BlobSidecar.Commitments = append(txSidecar.BlobSidecar.Commitments, sidecar.KzgCommitment)
+		txSidecar.BlobSidecar.Proofs = append(txSidecar.BlobSidecar.Proofs, sidecar.KzgProof)
+	}
+	sort.SliceStable(txSidecars, func(i, j int) bool {
+		return txIndices[txSidecars[i].TxHash] < txIndices[txSidecars[j].TxHash]
+	})
+	return txSidecars, nil
+}
+
+// GetBSCBlobTxSidecarByTxHash returns the blob sidecar of the tx, gorm.ErrRecordNotFound is returned when no blob of
+// the tx is archived
+func (b BlobService) GetBSCBlobTxSidecarByTxHash(ctx context.Context, txHash string, fullBlob bool) (txSidecar *models.BSCBlobTxSidecar, err error) {
+	ctx, span := tracing.StartSpan(ctx, "BlobService.GetBSCBlobTxSidecarByTxHash")
+	span.SetAttributes(attribute.String("tx_hash", txHash))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	_, dbSpan := tracing.StartSpan(ctx, "db.GetBlobsByTxHash")
+	blobMetas, err := b.blobDB.GetBlobsByTxHash(txHash)
+	tracing.EndSpan(dbSpan, err)
+	if err != nil {
+		return nil, err
+	}
+	if len(blobMetas) == 0 {
+		return nil, notFoundError(ReasonBlobNotFound, "no blob archived for tx %s%s", prefixHex, txHash)
+	}
+	txSidecars, err := b.GetBSCBlobTxSidecars(ctx, blobMetas[0].Slot, fullBlob)
+	if err != nil {
+		return nil, err
+	}
+	for _, txSidecar := range txSidecars {
+		if txSidecar.TxHash == fmt.Sprintf("%s%s", prefixHex, txHash) {
+			return txSidecar, nil
+		}
+	}
+	return nil, notFoundError(ReasonBlobNotFound, "no blob archived for tx %s%s", prefixHex, txHash)
+}
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/blob-hub/config"
+	"github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/external"
+)
+
+// chainHeaders serves the headers of the canonical blocks, the first of a number, and those replaced by a reorg by hash
+type chainHeaders struct {
+	external.IClient
+	canonical map[uint64]*types.Header
+	byHash    map[common.Hash]*types.Header
+}
+
+func newChainHeaders(headers ...*types.Header) *chainHeaders {
+	c := &chainHeaders{canonical: make(map[uint64]*types.Header), byHash: make(map[common.Hash]*types.Header)}
+	for _, header := range headers {
+		if _, ok := c.canonical[header.Number.Uint64()]; !ok {
+			c.canonical[header.Number.Uint64()] = header
+		}
+		c.byHash[header.Hash()] = header
+	}
+	return c
+}
+
+func (c *chainHeaders) GetBlockHeader(ctx context.Context, height uint64) (*types.Header, error) {
+	if header, ok := c.canonical[height]; ok {
+		return header, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func (c *chainHeaders) GetBlockHeaderByHash(ctx context.Context, hash common.Hash) (*types.Header, error) {
+	if header, ok := c.byHash[hash]; ok {
+		return header, nil
+	}
+	return nil, ethereum.NotFound
+}
+
+func bscHeader(number uint64, extra string) *types.Header {
+	return &types.Header{Number: new(big.Int).SetUint64(number), Extra: []byte(extra)}
+}
+
+func hashOf(header *types.Header) string {
+	return hex.EncodeToString(header.Hash().Bytes())
+}
+
+func TestGetBlockNumByHash(t *testing.T) {
+	recorded, unrecorded, reorged := bscHeader(10, "recorded"), bscHeader(11, "canonical"), bscHeader(11, "reorged")
+	blobDB := newTestBlobDB(t)
+	for _, block := range []*db.Block{{Slot: 10, BlockHash: hashOf(recorded)}, {Slot: 11}} {
+		if err := blobDB.SaveBlockAndBlob(block, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	chain := newChainHeaders(recorded, unrecorded, reorged)
+	tests := []struct {
+		name      string
+		liveChain bool
+		blockHash string
+		want      uint64
+		wantErr   error
+	}{
+		{name: "recorded", blockHash: hashOf(recorded), want: 10},
+		{name: "not recorded", blockHash: hashOf(unrecorded), wantErr: gorm.ErrRecordNotFound},
+		{name: "not recorded found on the chain", liveChain: true, blockHash: hashOf(unrecorded), want: 11},
+		{name: "replaced by a reorg", liveChain: true, blockHash: hashOf(reorged), wantErr: gorm.ErrRecordNotFound},
+		{name: "unknown to the chain", liveChain: true, blockHash: hashOf(bscHeader(12, "unknown")), wantErr: gorm.ErrRecordNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var chainClient external.IClient
+			if tt.liveChain {
+				chainClient = chain
+			}
+			svc := NewBlobService(blobDB, nil, nil, chainClient, &config.ServerConfig{Chain: config.BSC})
+			got, err := svc.GetBlockNumByHash(context.Background(), tt.blockHash)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("GetBlockNumByHash() = %d, %v, want %v", got, err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("GetBlockNumByHash() failed, err=%s", err.Error())
+			}
+			if got != tt.want {
+				t.Errorf("GetBlockNumByHash() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
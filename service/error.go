@@ -1,35 +1,202 @@
 package service
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"net"
+	"net/http"
+	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/external/cmn"
 	"github.com/bnb-chain/blob-hub/models"
 )
 
+// errorDomain is the domain of the reasons in the gRPC error details
+const errorDomain = "blob-hub"
+
+// Reason tells clients why a request failed in a machine-readable way
+type Reason string
+
+const (
+	ReasonInvalidArgument Reason = "INVALID_ARGUMENT"
+	ReasonNotAcceptable   Reason = "NOT_ACCEPTABLE"
+	ReasonNotSupported    Reason = "NOT_SUPPORTED"    // the request is not supported on the chain
+	ReasonBlockNotFound   Reason = "BLOCK_NOT_FOUND"  // the block was not produced, or is empty
+	ReasonNotArchivedYet  Reason = "NOT_ARCHIVED_YET" // the block is past the latest block archived
+	ReasonBlobNotFound    Reason = "BLOB_NOT_FOUND"
+	ReasonBundleNotFound  Reason = "BUNDLE_NOT_FOUND"
+	ReasonRateLimited     Reason = "RATE_LIMITED"
+	ReasonUnavailable     Reason = "UNAVAILABLE" // a dependency failed, retrying later may succeed
+	ReasonInternal        Reason = "INTERNAL"
+)
+
+// HTTPStatus returns the HTTP status code of the reason
+func (r Reason) HTTPStatus() int {
+	switch r {
+	case ReasonInvalidArgument:
+		return http.StatusBadRequest
+	case ReasonNotAcceptable:
+		return http.StatusNotAcceptable
+	case ReasonNotSupported, ReasonBlockNotFound, ReasonNotArchivedYet, ReasonBlobNotFound, ReasonBundleNotFound:
+		return http.StatusNotFound
+	case ReasonRateLimited:
+		return http.StatusTooManyRequests
+	case ReasonUnavailable:
+		return http.StatusServiceUnavailable
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// GRPCCode returns the gRPC status code of the reason
+func (r Reason) GRPCCode() codes.Code {
+	switch r {
+	case ReasonInvalidArgument, ReasonNotAcceptable:
+		return codes.InvalidArgument
+	case ReasonNotSupported:
+		return codes.Unimplemented
+	case ReasonBlockNotFound, ReasonNotArchivedYet, ReasonBlobNotFound, ReasonBundleNotFound:
+		return codes.NotFound
+	case ReasonRateLimited:
+		return codes.ResourceExhausted
+	case ReasonUnavailable:
+		return codes.Unavailable
+	default:
+		return codes.Internal
+	}
+}
+
 // Verify Interface Compliance
 var _ error = (*Err)(nil)
 
-// Err defines service errors.
+// Err is a service error along with its reason
 type Err struct {
-	Code    int64  `json:"code"`
-	Message string `json:"error"`
+	Reason  Reason
+	Message string // overrides the message of the error wrapped if set
+	Err     error
+}
+
+// NewError wraps the error with the reason
+func NewError(reason Reason, err error) error {
+	return &Err{Reason: reason, Err: err}
+}
+
+func (e *Err) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+	return e.Err.Error()
+}
+
+func (e *Err) Unwrap() error {
+	return e.Err
+}
+
+// ReasonOf returns the reason of an error. Errors without reason are given one by their type, and regarded as internal
+// if unknown.
+func ReasonOf(err error) Reason {
+	var serviceErr *Err
+	if errors.As(err, &serviceErr) {
+		return serviceErr.Reason
+	}
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return ReasonBlockNotFound
+	case errors.Is(err, ErrInvalidStreamRange):
+		return ReasonInvalidArgument
+	case errors.Is(err, ErrBeaconNotSupported), errors.Is(err, ErrNetworkNotConfigured):
+		return ReasonNotSupported
+	// the chain node serving invalid blobs is not the fault of the request, another node may serve valid ones
+	case errors.Is(err, ErrInvalidKZGProof), errors.Is(err, ErrBlobNotInBlock):
+		return ReasonUnavailable
+	case errors.Is(err, context.DeadlineExceeded), errors.Is(err, context.Canceled):
+		return ReasonUnavailable
+	}
+	var classified *cmn.ClassifiedError
+	if errors.As(err, &classified) {
+		switch classified.Class {
+		case cmn.ErrorClassRateLimited:
+			return ReasonRateLimited
+		case cmn.ErrorClassRetryable:
+			return ReasonUnavailable
+		// an archived blob missing from the bundle service is not the fault of the request either
+		case cmn.ErrorClassNotFound:
+			return ReasonUnavailable
+		default:
+			return ReasonInternal
+		}
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return ReasonUnavailable
+	}
+	return ReasonInternal
+}
+
+// RetryAfter returns the delay asked by an upstream rate limiting the service, 0 if not provided
+func RetryAfter(err error) time.Duration {
+	return cmn.RetryAfter(err)
 }
 
-func (e Err) Enrich(message string) Err {
-	return Err{
-		Code:    e.Code,
-		Message: fmt.Sprintf("%s: %s", e.Message, message),
+// ErrorPayload returns the REST payload of an error, the message of an internal error is not disclosed
+func ErrorPayload(err error) *models.Error {
+	reason := ReasonOf(err)
+	message := err.Error()
+	if reason == ReasonInternal {
+		message = "internal error"
+	}
+	return &models.Error{
+		Code:    int64(reason.HTTPStatus()),
+		Message: message,
+		Reason:  string(reason),
 	}
 }
 
-func (e Err) Error() string {
-	return fmt.Sprintf("%d: %s", e.Code, e.Message)
+// GRPCError returns the gRPC status error of an error, with its reason in an ErrorInfo detail
+func GRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	reason := ReasonOf(err)
+	code := reason.GRPCCode()
+	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		code = codes.DeadlineExceeded
+	case errors.Is(err, context.Canceled):
+		code = codes.Canceled
+	}
+	message := err.Error()
+	if reason == ReasonInternal {
+		message = "internal error"
+	}
+	st := status.New(code, message)
+	details := []protoadapt.MessageV1{&errdetails.ErrorInfo{Reason: string(reason), Domain: errorDomain}}
+	if retryAfter := RetryAfter(err); retryAfter > 0 {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(retryAfter)})
+	}
+	if withDetails, detailsErr := st.WithDetails(details...); detailsErr == nil {
+		st = withDetails
+	}
+	return st.Err()
 }
 
 func InternalError() *models.Error {
 	return &models.Error{
 		Code:    500,
 		Message: "internal error",
+		Reason:  string(ReasonInternal),
 	}
 }
 
@@ -37,5 +204,41 @@ func BadRequestWithError(err error) *models.Error {
 	return &models.Error{
 		Code:    400,
 		Message: err.Error(),
+		Reason:  string(ReasonInvalidArgument),
+	}
+}
+
+func NotFoundWithError(err error) *models.Error {
+	reason := ReasonOf(err)
+	if reason.HTTPStatus() != http.StatusNotFound {
+		reason = ReasonBlobNotFound
+	}
+	return &models.Error{
+		Code:    404,
+		Message: err.Error(),
+		Reason:  string(reason),
+	}
+}
+
+func NotAcceptableWithError(err error) *models.Error {
+	return &models.Error{
+		Code:    406,
+		Message: err.Error(),
+		Reason:  string(ReasonNotAcceptable),
+	}
+}
+
+// notFoundError returns the error of a block missing from the archive, it still matches gorm.ErrRecordNotFound
+func notFoundError(reason Reason, format string, args ...interface{}) error {
+	return &Err{Reason: reason, Message: fmt.Sprintf(format, args...), Err: gorm.ErrRecordNotFound}
+}
+
+// blockNotFoundError tells a block past the latest block archived, which might be archived later, from a block missing
+// from the archive
+func blockNotFoundError(blobDB db.BlobDao, blockNumOrSlot uint64) error {
+	head, err := blobDB.GetLatestProcessedBlock()
+	if err == nil && head.Slot < blockNumOrSlot {
+		return notFoundError(ReasonNotArchivedYet, "block %d is not archived yet", blockNumOrSlot)
 	}
+	return notFoundError(ReasonBlockNotFound, "block %d is not archived", blockNumOrSlot)
 }
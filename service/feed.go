@@ -0,0 +1,307 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/blob-hub/config"
+	"github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/logging"
+	"github.com/bnb-chain/blob-hub/metrics"
+	"github.com/bnb-chain/blob-hub/models"
+	"github.com/bnb-chain/blob-hub/util"
+)
+
+const (
+	// feedBatchSize is the number of block events read from DB at once
+	feedBatchSize = 256
+	// feedBufferSize is the number of the latest events kept in memory, the subscribers behind them read from DB
+	feedBufferSize = 1024
+	// feedGapTimeout is how long a gap in the event ids is waited for, as the transaction of the missing id might
+	// commit after the later ones. The gap left by a transaction rolled back is skipped afterwards.
+	feedGapTimeout = 10 * time.Second
+	// FeedKeepaliveInterval is the idle time after which a subscriber is called with a nil event
+	FeedKeepaliveInterval = 15 * time.Second
+)
+
+var ErrTooManySubscribers = NewError(ReasonUnavailable, errors.New("the server has reached its maximum subscribers, retry later"))
+
+// Feed serves the subscriptions to the block events, which the syncer records to DB along with the blocks
+type Feed interface {
+	Subscribe(ctx context.Context, transport string, after *int64, types []string, fn func(*models.BlockEvent) error) error
+}
+
+// FeedService polls the block events from DB once for all the subscribers, and keeps the latest ones in memory
+type FeedService struct {
+	blobDB db.BlobDao
+	cfg    *config.ServerConfig
+
+	mu          sync.Mutex
+	started     bool
+	head        int64 // the id of the latest event read, the subscribers are served up to it
+	bufferFrom  int64 // the buffer holds the events within (bufferFrom, head] in id order
+	buffer      []*models.BlockEvent
+	updated     chan struct{} // closed when head moves
+	subscribers int
+}
+
+func NewFeedService(blobDB db.BlobDao, config *config.ServerConfig) Feed {
+	return &FeedService{
+		blobDB:  blobDB,
+		cfg:     config,
+		updated: make(chan struct{}),
+	}
+}
+
+// Subscribe calls fn with the events of the types, all if none, after the event of id after, or after the latest event
+// if after is nil. fn is called with nil once there is no event to send, and again whenever no event is sent for
+// FeedKeepaliveInterval, so that the transports can start their responses and keep their connections alive. It returns
+// when ctx is done or fn fails, the events pruned by the syncer are skipped.
+func (f *FeedService) Subscribe(ctx context.Context, transport string, after *int64, types []string, fn func(*models.BlockEvent) error) error {
+	cursor, err := f.join(transport)
+	if err != nil {
+		return err
+	}
+	defer f.leave(transport)
+	if after != nil {
+		cursor = *after
+	}
+	wanted := make(map[string]bool, len(types))
+	for _, t := range types {
+		wanted[t] = true
+	}
+
+	var lastSent time.Time
+	for {
+		if err = ctx.Err(); err != nil {
+			return err
+		}
+		f.mu.Lock()
+		updated, head, bufferFrom, buffer := f.updated, f.head, f.bufferFrom, f.buffer
+		f.mu.Unlock()
+
+		var events []*models.BlockEvent
+		if cursor < bufferFrom {
+			events, err = f.readEventsBetween(cursor, bufferFrom)
+			if err != nil {
+				return err
+			}
+			if len(events) == 0 {
+				cursor = bufferFrom
+				continue
+			}
+		} else if cursor < head {
+			events = buffer[sort.Search(len(buffer), func(i int) bool { return buffer[i].ID > cursor }):]
+		}
+		if len(events) == 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-updated:
+			case <-time.After(time.Until(lastSent.Add(FeedKeepaliveInterval))):
+				if err = fn(nil); err != nil {
+					return err
+				}
+				lastSent = time.Now()
+			}
+			continue
+		}
+		for _, event := range events {
+			cursor = event.ID
+			if len(wanted) != 0 && !wanted[event.Type] {
+				continue
+			}
+			if err = fn(event); err != nil {
+				return err
+			}
+			lastSent = time.Now()
+		}
+	}
+}
+
+// join counts a subscriber in, the events are polled from the first subscriber on. It returns the id of the latest
+// event.
+func (f *FeedService) join(transport string) (int64, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.subscribers >= f.cfg.SubscriptionConfig.GetMaxSubscribers() {
+		return 0, ErrTooManySubscribers
+	}
+	if !f.started {
+		head, err := f.blobDB.GetLatestBlockEventID()
+		if err != nil {
+			return 0, err
+		}
+		f.head, f.bufferFrom = head, head
+		f.started = true
+		metrics.BlockEventIDGauge.Set(float64(head))
+		go f.poll()
+	}
+	f.subscribers++
+	metrics.SubscribersGauge.WithLabelValues(transport).Inc()
+	return f.head, nil
+}
+
+func (f *FeedService) leave(transport string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.subscribers--
+	metrics.SubscribersGauge.WithLabelValues(transport).Dec()
+}
+
+func (f *FeedService) poll() {
+	var gapSince time.Time
+	pollTicker := time.NewTicker(f.cfg.SubscriptionConfig.GetPollInterval())
+	for range pollTicker.C {
+		for {
+			read, err := f.readNewEvents(&gapSince)
+			if err != nil {
+				logging.Logger.Errorf("failed to read block events, err=%s", err.Error())
+				break
+			}
+			if read < feedBatchSize {
+				break
+			}
+		}
+	}
+}
+
+// readNewEvents reads the events after head into the buffer and wakes the subscribers up, it returns the number of
+// events read. Only the poll goroutine moves head.
+func (f *FeedService) readNewEvents(gapSince *time.Time) (int, error) {
+	events, err := f.blobDB.GetBlockEventsAfter(f.head, feedBatchSize)
+	if err != nil {
+		return 0, err
+	}
+	next, read := f.head, 0
+	for _, event := range events {
+		if event.Id != next+1 {
+			if gapSince.IsZero() {
+				*gapSince = time.Now()
+			}
+			if time.Since(*gapSince) < feedGapTimeout {
+				break
+			}
+		}
+		*gapSince = time.Time{}
+		next = event.Id
+		read++
+	}
+	if read == 0 {
+		return 0, nil
+	}
+	blockEvents, err := f.toBlockEvents(events[:read])
+	if err != nil {
+		return 0, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.buffer = append(f.buffer, blockEvents...)
+	if dropped := len(f.buffer) - feedBufferSize; dropped > 0 {
+		f.bufferFrom = f.buffer[dropped-1].ID
+		f.buffer = f.buffer[dropped:]
+	}
+	f.head = next
+	close(f.updated)
+	f.updated = make(chan struct{})
+	metrics.BlockEventIDGauge.Set(float64(next))
+	return read, nil
+}
+
+// readEventsBetween reads a batch of the events within (after, upTo] for a subscriber behind the buffer
+func (f *FeedService) readEventsBetween(after, upTo int64) ([]*models.BlockEvent, error) {
+	events, err := f.blobDB.GetBlockEventsAfter(after, feedBatchSize)
+	if err != nil {
+		return nil, err
+	}
+	end := sort.Search(len(events), func(i int) bool { return events[i].Id > upTo })
+	return f.toBlockEvents(events[:end])
+}
+
+// toBlockEvents attaches the metadata of the blocks and their blobs to the events. A block calibrated since its event
+// is described as it is now, and a block no longer archived only by its block id.
+func (f *FeedService) toBlockEvents(events []*db.BlockEvent) ([]*models.BlockEvent, error) {
+	if len(events) == 0 {
+		return nil, nil
+	}
+	minSlot, maxSlot := events[0].Slot, events[0].Slot
+	slots := make([]uint64, 0, len(events))
+	seen := make(map[uint64]bool, len(events))
+	for _, event := range events {
+		minSlot, maxSlot = min(minSlot, event.Slot), max(maxSlot, event.Slot)
+		if !seen[event.Slot] {
+			seen[event.Slot] = true
+			slots = append(slots, event.Slot)
+		}
+	}
+
+	blocks := make(map[uint64]*db.Block, len(slots))
+	blobs := make(map[uint64][]*db.Blob, len(slots))
+	// the events of a batch are mostly of consecutive blocks, they are queried by range unless they are far apart
+	if maxSlot-minSlot < 2*feedBatchSize {
+		blockMetas, err := f.blobDB.GetBlocksBetween(minSlot, maxSlot)
+		if err != nil {
+			return nil, err
+		}
+		for _, block := range blockMetas {
+			blocks[block.Slot] = block
+		}
+		blobMetas, err := f.blobDB.GetBlobBetweenBlocks(minSlot, maxSlot)
+		if err != nil {
+			return nil, err
+		}
+		for _, blob := range blobMetas {
+			blobs[blob.Slot] = append(blobs[blob.Slot], blob)
+		}
+	} else {
+		for _, slot := range slots {
+			block, err := f.blobDB.GetBlock(slot)
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			blocks[slot] = block
+			if blobs[slot], err = f.blobDB.GetBlobByBlockID(slot); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	blockEvents := make([]*models.BlockEvent, 0, len(events))
+	for _, event := range events {
+		blockEvent := &models.BlockEvent{
+			ID:      event.Id,
+			Type:    string(event.EventType),
+			BlockID: util.Uint64ToString(event.Slot),
+			Blobs:   make([]*models.BlobMeta, 0, len(blobs[event.Slot])),
+			Time:    event.CreatedTime,
+		}
+		if block, ok := blocks[event.Slot]; ok {
+			if block.Root != "" {
+				blockEvent.Root = fmt.Sprintf("%s%s", prefixHex, block.Root)
+			}
+			if block.BlockHash != "" {
+				blockEvent.BlockHash = fmt.Sprintf("%s%s", prefixHex, block.BlockHash)
+			}
+			if block.ELBlockHeight != 0 {
+				blockEvent.ElBlockHeight = util.Uint64ToString(block.ELBlockHeight)
+			}
+			blockEvent.BundleName = block.BundleName
+			blockEvent.BlobCount = int64(block.BlobCount)
+		}
+		for _, blob := range blobs[event.Slot] {
+			blockEvent.Blobs = append(blockEvent.Blobs, toBlobMeta(blob))
+		}
+		blockEvents = append(blockEvents, blockEvent)
+	}
+	return blockEvents, nil
+}
@@ -0,0 +1,105 @@
+package service
+
+import (
+	"testing"
+	"time"
+
+	"github.com/bnb-chain/blob-hub/config"
+	"github.com/bnb-chain/blob-hub/db"
+)
+
+// feedTestDao serves the block events of a feed, the blocks and blobs they describe are not recorded
+type feedTestDao struct {
+	db.BlobDao
+	events []*db.BlockEvent
+}
+
+func (d *feedTestDao) GetBlockEventsAfter(id int64, limit int) ([]*db.BlockEvent, error) {
+	events := make([]*db.BlockEvent, 0)
+	for _, event := range d.events {
+		if event.Id > id && len(events) < limit {
+			events = append(events, event)
+		}
+	}
+	return events, nil
+}
+
+func (d *feedTestDao) GetBlocksBetween(startSlot, endSlot uint64) ([]*db.Block, error) {
+	return nil, nil
+}
+
+func (d *feedTestDao) GetBlobBetweenBlocks(startSlot, endSlot uint64) ([]*db.Blob, error) {
+	return nil, nil
+}
+
+func TestFeedReadNewEventsGaps(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  []int64
+		// gapAge is how long the gap at head has been waited for, none if 0
+		gapAge       time.Duration
+		wantRead     int
+		wantHead     int64
+		wantGapSince bool
+		// freshGap tells whether the gap is first seen by the read, and waited for from then on
+		freshGap bool
+	}{
+		{name: "no event", ids: nil, wantRead: 0, wantHead: 0},
+		{name: "consecutive", ids: []int64{1, 2, 3}, wantRead: 3, wantHead: 3},
+		{name: "gap waited for", ids: []int64{1, 2, 4, 5}, wantRead: 2, wantHead: 2, wantGapSince: true, freshGap: true},
+		{name: "gap at head waited for", ids: []int64{2, 3}, wantRead: 0, wantHead: 0, wantGapSince: true, freshGap: true},
+		{name: "gap within the timeout", ids: []int64{2, 3}, gapAge: feedGapTimeout / 2, wantRead: 0, wantHead: 0, wantGapSince: true},
+		{name: "gap skipped after the timeout", ids: []int64{2, 3}, gapAge: feedGapTimeout, wantRead: 2, wantHead: 3},
+		{name: "next gap waited for afresh", ids: []int64{2, 4}, gapAge: feedGapTimeout, wantRead: 1, wantHead: 2, wantGapSince: true, freshGap: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dao := &feedTestDao{}
+			for _, id := range tt.ids {
+				dao.events = append(dao.events, &db.BlockEvent{Id: id, EventType: db.BlockArchived, Slot: uint64(id)})
+			}
+			f := NewFeedService(dao, &config.ServerConfig{}).(*FeedService)
+			updated := f.updated
+			var gapSince time.Time
+			if tt.gapAge != 0 {
+				gapSince = time.Now().Add(-tt.gapAge)
+			}
+			begin := time.Now()
+
+			read, err := f.readNewEvents(&gapSince)
+			if err != nil {
+				t.Fatalf("readNewEvents() failed, err=%s", err.Error())
+			}
+			if read != tt.wantRead || f.head != tt.wantHead {
+				t.Fatalf("readNewEvents() read %d up to %d, want %d up to %d", read, f.head, tt.wantRead, tt.wantHead)
+			}
+			if gapSince.IsZero() == tt.wantGapSince {
+				t.Errorf("gap since %s, want a gap %t", gapSince, tt.wantGapSince)
+			}
+			if tt.freshGap && gapSince.Before(begin) {
+				t.Errorf("gap since %s, want from %s on", gapSince, begin)
+			}
+			if !tt.freshGap && tt.wantGapSince && !gapSince.Before(begin) {
+				t.Errorf("gap since %s, want the gap waited for before %s", gapSince, begin)
+			}
+			if len(f.buffer) != tt.wantRead {
+				t.Fatalf("buffer holds %d events, want %d", len(f.buffer), tt.wantRead)
+			}
+			for i, event := range f.buffer {
+				if event.ID != tt.ids[i] {
+					t.Errorf("buffer[%d] = %d, want %d", i, event.ID, tt.ids[i])
+				}
+			}
+			select {
+			case <-updated:
+				if read == 0 {
+					t.Errorf("subscribers woken up without a new event")
+				}
+			default:
+				if read != 0 {
+					t.Errorf("subscribers not woken up for the new events")
+				}
+			}
+		})
+	}
+}
@@ -1,3 +1,7 @@
 package service
 
 var BlobSvc Blob
+
+var BeaconSvc Beacon
+
+var FeedSvc Feed
@@ -0,0 +1,237 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+	"go.opentelemetry.io/otel/attribute"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/blob-hub/config"
+	"github.com/bnb-chain/blob-hub/logging"
+	"github.com/bnb-chain/blob-hub/models"
+	"github.com/bnb-chain/blob-hub/tracing"
+	"github.com/bnb-chain/blob-hub/types"
+	"github.com/bnb-chain/blob-hub/util"
+)
+
+var (
+	ErrInvalidKZGProof = errors.New("invalid KZG proof")
+	ErrBlobNotInBlock  = errors.New("blob not included in the block")
+)
+
+// getUnarchivedBlobSidecars fetches the blob sidecars of a block not archived yet from the chain. The chain nodes are
+// not trusted like the archive, so each blob is verified against its KZG proof, and its commitment against the block:
+// the KZG commitment inclusion proof against the body root of the block header on ETH, the versioned hashes of the
+// blob txs of the block on BSC. gorm.ErrRecordNotFound is returned when the chain has no blob at the block either.
+func (b BlobService) getUnarchivedBlobSidecars(ctx context.Context, blockNumOrSlot uint64, indices []int64) (sideCars []*models.Sidecar, err error) {
+	ctx, span := tracing.StartSpan(ctx, "BlobService.getUnarchivedBlobSidecars")
+	span.SetAttributes(attribute.Int64("block_id", int64(blockNumOrSlot)))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	chainSidecars, err := b.chainClient.GetBlob(ctx, blockNumOrSlot)
+	if err != nil {
+		if errors.Is(err, ethereum.NotFound) {
+			return nil, gorm.ErrRecordNotFound
+		}
+		return nil, err
+	}
+	// a block without blob cannot be told from a slot without block, nor one not produced yet
+	if len(chainSidecars) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+	if b.cfg.Chain == config.BSC {
+		err = b.verifyBlobTxInclusion(ctx, blockNumOrSlot, chainSidecars)
+	} else {
+		err = b.verifyCommitmentInclusion(ctx, blockNumOrSlot, chainSidecars)
+	}
+	if err != nil {
+		logging.Logger.Errorf("the chain served a blob sidecar not of block %d, err=%s", blockNumOrSlot, err.Error())
+		return nil, err
+	}
+
+	wanted := make(map[string]struct{}, len(indices))
+	for _, idx := range indices {
+		wanted[util.Int64ToString(idx)] = struct{}{}
+	}
+	sideCars = make([]*models.Sidecar, 0, len(chainSidecars))
+	for _, chainSidecar := range chainSidecars {
+		if _, ok := wanted[chainSidecar.Index]; len(indices) != 0 && !ok {
+			continue
+		}
+		if err = verifyKZGProof(chainSidecar); err != nil {
+			logging.Logger.Errorf("the chain served an invalid blob sidecar at block %d, err=%s", blockNumOrSlot, err.Error())
+			return nil, err
+		}
+		sideCars = append(sideCars, toUnarchivedSidecar(chainSidecar))
+	}
+	return sideCars, nil
+}
+
+func verifyKZGProof(sidecar *types.GeneralSideCar) error {
+	var (
+		blob       kzg4844.Blob
+		commitment kzg4844.Commitment
+		proof      kzg4844.Proof
+	)
+	fields := []struct {
+		value string
+		dst   []byte
+	}{{sidecar.Blob, blob[:]}, {sidecar.KzgCommitment, commitment[:]}, {sidecar.KzgProof, proof[:]}}
+	for _, field := range fields {
+		decoded, err := hexutil.Decode(field.value)
+		if err != nil || len(decoded) != len(field.dst) {
+			return fmt.Errorf("%w, malformed blob sidecar %s", ErrInvalidKZGProof, sidecar.Index)
+		}
+		copy(field.dst, decoded)
+	}
+	if err := kzg4844.VerifyBlobProof(&blob, commitment, proof); err != nil {
+		return fmt.Errorf("%w, blob sidecar %s, err=%s", ErrInvalidKZGProof, sidecar.Index, err.Error())
+	}
+	return nil
+}
+
+// verifyCommitmentInclusion verifies the sidecars are of the block header of the slot, and their commitments are in
+// its body
+func (b BlobService) verifyCommitmentInclusion(ctx context.Context, slot uint64, sidecars []*types.GeneralSideCar) error {
+	ctx, span := tracing.StartSpan(ctx, "ChainClient.GetBeaconHeader")
+	headerResp, err := b.chainClient.GetBeaconHeader(ctx, slot)
+	tracing.EndSpan(span, err)
+	if err != nil {
+		return err
+	}
+	if headerResp == nil || headerResp.Data == nil {
+		return fmt.Errorf("%w, no block header at slot %d", ErrBlobNotInBlock, slot)
+	}
+	blockRoot, err := hexutil.Decode(headerResp.Data.Root)
+	if err != nil {
+		return fmt.Errorf("%w, malformed block root %s", ErrBlobNotInBlock, headerResp.Data.Root)
+	}
+	for _, sidecar := range sidecars {
+		pbSidecar, err := toProtoBlobSidecar(toUnarchivedSidecar(sidecar))
+		if err != nil {
+			return fmt.Errorf("%w, malformed blob sidecar %s, err=%s", ErrBlobNotInBlock, sidecar.Index, err.Error())
+		}
+		headerRoot, err := pbSidecar.SignedBlockHeader.Header.HashTreeRoot()
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(headerRoot[:], blockRoot) {
+			return fmt.Errorf("%w, blob sidecar %s is of block %s", ErrBlobNotInBlock, sidecar.Index, hexutil.Encode(headerRoot[:]))
+		}
+		if !verifyKZGInclusionProof(pbSidecar) {
+			return fmt.Errorf("%w, invalid KZG commitment inclusion proof of blob sidecar %s", ErrBlobNotInBlock, sidecar.Index)
+		}
+	}
+	return nil
+}
+
+const (
+	// maxBlobCommitmentsPerBlock is MAX_BLOB_COMMITMENTS_PER_BLOCK, the limit of the commitments list of a block body
+	maxBlobCommitmentsPerBlock = 4096
+	// kzgInclusionProofDepth is the depth of the commitments in the block body tree, 12 within the commitments list, 1
+	// for its length and 4 within the 16 leaves of the body
+	kzgInclusionProofDepth = 17
+	// kzgCommitmentsGIndexOffset is the position of the commitment 0 among the leaves of depth kzgInclusionProofDepth,
+	// from the commitments list being the field 11 of the body
+	kzgCommitmentsGIndexOffset = 54 * maxBlobCommitmentsPerBlock
+)
+
+// verifyKZGInclusionProof verifies the Merkle branch of the commitment of the sidecar against the body root of its
+// header, the layout is the same for the Deneb and Electra bodies
+func verifyKZGInclusionProof(sidecar *ethpb.BlobSidecar) bool {
+	proof := sidecar.CommitmentInclusionProof
+	if len(proof) != kzgInclusionProofDepth || len(sidecar.KzgCommitment) != 48 || sidecar.Index >= maxBlobCommitmentsPerBlock {
+		return false
+	}
+	// the commitment is a vector of 48 bytes, its root the hash of its two chunks
+	var chunks [64]byte
+	copy(chunks[:], sidecar.KzgCommitment)
+	node := sha256.Sum256(chunks[:])
+	index := kzgCommitmentsGIndexOffset + sidecar.Index
+	for i, sibling := range proof {
+		if len(sibling) != 32 {
+			return false
+		}
+		if (index>>i)&1 == 1 {
+			node = sha256.Sum256(append(append(make([]byte, 0, 64), sibling...), node[:]...))
+		} else {
+			node = sha256.Sum256(append(append(make([]byte, 0, 64), node[:]...), sibling...))
+		}
+	}
+	return bytes.Equal(node[:], sidecar.SignedBlockHeader.Header.BodyRoot)
+}
+
+// verifyBlobTxInclusion verifies the commitment of each sidecar hashes to the versioned hash at its position in its tx
+// of the block. The blobs of a tx are consecutive in index order.
+func (b BlobService) verifyBlobTxInclusion(ctx context.Context, blockNum uint64, sidecars []*types.GeneralSideCar) error {
+	ctx, span := tracing.StartSpan(ctx, "ChainClient.BlockByNumber")
+	block, err := b.chainClient.BlockByNumber(ctx, new(big.Int).SetUint64(blockNum))
+	tracing.EndSpan(span, err)
+	if err != nil {
+		return err
+	}
+	blobHashes := make(map[common.Hash][]common.Hash)
+	for _, tx := range block.Transactions() {
+		if len(tx.BlobHashes()) != 0 {
+			blobHashes[tx.Hash()] = tx.BlobHashes()
+		}
+	}
+	positions := make(map[common.Hash]int)
+	for _, sidecar := range sidecars {
+		var commitment kzg4844.Commitment
+		decoded, err := hexutil.Decode(sidecar.KzgCommitment)
+		if err != nil || len(decoded) != len(commitment) {
+			return fmt.Errorf("%w, malformed commitment of blob sidecar %s", ErrBlobNotInBlock, sidecar.Index)
+		}
+		copy(commitment[:], decoded)
+		txHash := common.HexToHash(sidecar.TxHash)
+		position := positions[txHash]
+		positions[txHash]++
+		if position >= len(blobHashes[txHash]) {
+			return fmt.Errorf("%w, blob sidecar %s is not of a blob tx of the block, tx %s", ErrBlobNotInBlock, sidecar.Index, txHash.Hex())
+		}
+		if versionedHash := kzg4844.CalcBlobHashV1(sha256.New(), &commitment); versionedHash != blobHashes[txHash][position] {
+			return fmt.Errorf("%w, blob sidecar %s does not match the blob %d of tx %s", ErrBlobNotInBlock, sidecar.Index, position, txHash.Hex())
+		}
+	}
+	return nil
+}
+
+func toUnarchivedSidecar(sidecar *types.GeneralSideCar) *models.Sidecar {
+	var header *models.SidecarSignedBlockHeader
+	if sidecar.SignedBeaconBlockHeader != nil && sidecar.SignedBeaconBlockHeader.Message != nil {
+		message := sidecar.SignedBeaconBlockHeader.Message
+		header = &models.SidecarSignedBlockHeader{
+			Message: &models.SidecarSignedBlockHeaderMessage{
+				BodyRoot:      message.BodyRoot,
+				ParentRoot:    message.ParentRoot,
+				StateRoot:     message.StateRoot,
+				ProposerIndex: message.ProposerIndex,
+				Slot:          message.Slot,
+			},
+			Signature: sidecar.SignedBeaconBlockHeader.Signature,
+		}
+	}
+	return &models.Sidecar{
+		Blob:                        sidecar.Blob,
+		Index:                       sidecar.Index,
+		KzgCommitmentInclusionProof: sidecar.CommitmentInclusionProof,
+		KzgCommitment:               sidecar.KzgCommitment,
+		KzgProof:                    sidecar.KzgProof,
+		SignedBlockHeader:           header,
+		TxIndex:                     sidecar.TxIndex,
+		TxHash:                      strings.TrimPrefix(sidecar.TxHash, prefixHex), // as the tx hashes archived
+		Unarchived:                  true,
+	}
+}
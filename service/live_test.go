@@ -0,0 +1,361 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto/kzg4844"
+	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	enginev1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+
+	"github.com/bnb-chain/blob-hub/config"
+	"github.com/bnb-chain/blob-hub/external"
+	types2 "github.com/bnb-chain/blob-hub/types"
+	"github.com/bnb-chain/blob-hub/util"
+)
+
+// liveChain serves the blob sidecars of a single block, with its beacon header on ETH and its txs on BSC
+type liveChain struct {
+	external.IClient
+	sidecars []*types2.GeneralSideCar
+	header   *structs.GetBlockHeaderResponse
+	block    *types.Block
+}
+
+func (c *liveChain) GetBlob(ctx context.Context, blockID uint64) ([]*types2.GeneralSideCar, error) {
+	return c.sidecars, nil
+}
+
+func (c *liveChain) GetBeaconHeader(ctx context.Context, slotNumber uint64) (*structs.GetBlockHeaderResponse, error) {
+	return c.header, nil
+}
+
+func (c *liveChain) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
+	return c.block, nil
+}
+
+// kzgBlob is a blob with its commitment and proof, the blobs differ by their first field element
+type kzgBlob struct {
+	blob       kzg4844.Blob
+	commitment kzg4844.Commitment
+	proof      kzg4844.Proof
+}
+
+func newKZGBlob(t *testing.T, seed byte) kzgBlob {
+	var b kzgBlob
+	b.blob[31] = seed
+	var err error
+	if b.commitment, err = kzg4844.BlobToCommitment(&b.blob); err != nil {
+		t.Fatal(err)
+	}
+	if b.proof, err = kzg4844.ComputeBlobProof(&b.blob, b.commitment); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func (b kzgBlob) sidecar(index string) *types2.GeneralSideCar {
+	return &types2.GeneralSideCar{Sidecar: structs.Sidecar{
+		Index:         index,
+		Blob:          hexutil.Encode(b.blob[:]),
+		KzgCommitment: hexutil.Encode(b.commitment[:]),
+		KzgProof:      hexutil.Encode(b.proof[:]),
+	}}
+}
+
+func hashPair(a, b [32]byte) [32]byte {
+	return sha256.Sum256(append(a[:], b[:]...))
+}
+
+// merkleLayers returns the layers of the tree of the leaves padded to 2^depth, from the leaves up to the root
+func merkleLayers(leaves [][32]byte, depth int) [][][32]byte {
+	layer := make([][32]byte, 1<<depth)
+	copy(layer, leaves)
+	layers := [][][32]byte{layer}
+	for d := 0; d < depth; d++ {
+		next := make([][32]byte, len(layer)/2)
+		for i := range next {
+			next[i] = hashPair(layer[2*i], layer[2*i+1])
+		}
+		layers, layer = append(layers, next), next
+	}
+	return layers
+}
+
+func lengthChunk(n int) [32]byte {
+	var chunk [32]byte
+	binary.LittleEndian.PutUint64(chunk[:], uint64(n))
+	return chunk
+}
+
+// emptyListRoot is the root of an empty SSZ list of 2^depth chunks at most
+func emptyListRoot(depth int) [32]byte {
+	return hashPair(merkleLayers(nil, depth)[depth][0], lengthChunk(0))
+}
+
+// newDenebBlock builds a Deneb block body of the commitments, its header at the slot and the inclusion proofs of the
+// commitments. The body tree is built field by field, and checked against the SSZ root of the body.
+func newDenebBlock(t *testing.T, slot uint64, commitments ...kzg4844.Commitment) (*ethpb.BeaconBlockHeader, [][]string) {
+	body := &ethpb.BeaconBlockBodyDeneb{
+		RandaoReveal:  bytes.Repeat([]byte{1}, 96),
+		Eth1Data:      &ethpb.Eth1Data{DepositRoot: make([]byte, 32), DepositCount: 3, BlockHash: make([]byte, 32)},
+		Graffiti:      bytes.Repeat([]byte{2}, 32),
+		SyncAggregate: &ethpb.SyncAggregate{SyncCommitteeBits: make([]byte, 64), SyncCommitteeSignature: make([]byte, 96)},
+		ExecutionPayload: &enginev1.ExecutionPayloadDeneb{
+			ParentHash:    make([]byte, 32),
+			FeeRecipient:  make([]byte, 20),
+			StateRoot:     make([]byte, 32),
+			ReceiptsRoot:  make([]byte, 32),
+			LogsBloom:     make([]byte, 256),
+			PrevRandao:    make([]byte, 32),
+			BlockNumber:   slot,
+			BaseFeePerGas: make([]byte, 32),
+			BlockHash:     make([]byte, 32),
+		},
+	}
+	commitmentRoots := make([][32]byte, len(commitments))
+	for i, commitment := range commitments {
+		body.BlobKzgCommitments = append(body.BlobKzgCommitments, commitment[:])
+		var chunks [64]byte
+		copy(chunks[:], commitment[:])
+		commitmentRoots[i] = sha256.Sum256(chunks[:])
+	}
+
+	var randaoChunks [][32]byte
+	for i := 0; i < len(body.RandaoReveal); i += 32 {
+		randaoChunks = append(randaoChunks, [32]byte(body.RandaoReveal[i:i+32]))
+	}
+	eth1DataRoot, err := body.Eth1Data.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	syncAggregateRoot, err := body.SyncAggregate.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	payloadRoot, err := body.ExecutionPayload.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	commitmentLayers := merkleLayers(commitmentRoots, 12)
+	fieldRoots := [][32]byte{
+		merkleLayers(randaoChunks, 2)[2][0],
+		eth1DataRoot,
+		[32]byte(body.Graffiti),
+		emptyListRoot(4), // proposer slashings, 16 at most
+		emptyListRoot(1), // attester slashings, 2 at most
+		emptyListRoot(7), // attestations, 128 at most
+		emptyListRoot(4), // deposits, 16 at most
+		emptyListRoot(4), // voluntary exits, 16 at most
+		syncAggregateRoot,
+		payloadRoot,
+		emptyListRoot(4), // bls to execution changes, 16 at most
+		hashPair(commitmentLayers[12][0], lengthChunk(len(commitments))),
+	}
+	bodyLayers := merkleLayers(fieldRoots, 4)
+	bodyRoot, err := body.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bodyLayers[4][0] != bodyRoot {
+		t.Fatalf("body tree root %x, want the SSZ root %x", bodyLayers[4][0], bodyRoot)
+	}
+
+	proofs := make([][]string, len(commitments))
+	for i := range commitments {
+		for d := 0; d < 12; d++ {
+			proofs[i] = append(proofs[i], hexutil.Encode(commitmentLayers[d][(i>>d)^1][:]))
+		}
+		length := lengthChunk(len(commitments))
+		proofs[i] = append(proofs[i], hexutil.Encode(length[:]))
+		for d := 0; d < 4; d++ {
+			proofs[i] = append(proofs[i], hexutil.Encode(bodyLayers[d][(11>>d)^1][:]))
+		}
+	}
+	header := &ethpb.BeaconBlockHeader{
+		Slot:          primitives.Slot(slot),
+		ProposerIndex: 7,
+		ParentRoot:    bytes.Repeat([]byte{3}, 32),
+		StateRoot:     bytes.Repeat([]byte{4}, 32),
+		BodyRoot:      bodyRoot[:],
+	}
+	return header, proofs
+}
+
+func toSignedHeader(header *ethpb.BeaconBlockHeader) *structs.SignedBeaconBlockHeader {
+	return &structs.SignedBeaconBlockHeader{
+		Message: &structs.BeaconBlockHeader{
+			Slot:          util.Uint64ToString(uint64(header.Slot)),
+			ProposerIndex: util.Uint64ToString(uint64(header.ProposerIndex)),
+			ParentRoot:    hexutil.Encode(header.ParentRoot),
+			StateRoot:     hexutil.Encode(header.StateRoot),
+			BodyRoot:      hexutil.Encode(header.BodyRoot),
+		},
+		Signature: hexutil.Encode(make([]byte, 96)),
+	}
+}
+
+func headerResponse(t *testing.T, header *ethpb.BeaconBlockHeader) *structs.GetBlockHeaderResponse {
+	root, err := header.HashTreeRoot()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &structs.GetBlockHeaderResponse{Data: &structs.SignedBeaconBlockHeaderContainer{
+		Header:    toSignedHeader(header),
+		Root:      hexutil.Encode(root[:]),
+		Canonical: true,
+	}}
+}
+
+func TestGetUnarchivedBlobSidecarsETH(t *testing.T) {
+	blob0, blob1, other := newKZGBlob(t, 0), newKZGBlob(t, 1), newKZGBlob(t, 2)
+	header, proofs := newDenebBlock(t, 1, blob0.commitment, blob1.commitment)
+	otherHeader, _ := newDenebBlock(t, 1, other.commitment)
+	// newChain serves the two blobs of the block with their inclusion proofs
+	newChain := func() *liveChain {
+		chain := &liveChain{header: headerResponse(t, header)}
+		for i, blob := range []kzgBlob{blob0, blob1} {
+			sidecar := blob.sidecar(util.Int64ToString(int64(i)))
+			sidecar.SignedBeaconBlockHeader = toSignedHeader(header)
+			sidecar.CommitmentInclusionProof = append([]string(nil), proofs[i]...)
+			chain.sidecars = append(chain.sidecars, sidecar)
+		}
+		return chain
+	}
+	tests := []struct {
+		name    string
+		modify  func(chain *liveChain)
+		indices []int64
+		wantErr error
+	}{
+		{name: "of the block", modify: func(chain *liveChain) {}},
+		{name: "of the block, by index", modify: func(chain *liveChain) {}, indices: []int64{1}},
+		{name: "of another block", modify: func(chain *liveChain) {
+			chain.header = headerResponse(t, otherHeader)
+		}, wantErr: ErrBlobNotInBlock},
+		{name: "no block header", modify: func(chain *liveChain) {
+			chain.header = &structs.GetBlockHeaderResponse{}
+		}, wantErr: ErrBlobNotInBlock},
+		{name: "tampered inclusion proof", modify: func(chain *liveChain) {
+			chain.sidecars[1].CommitmentInclusionProof[3] = hexutil.Encode(make([]byte, 32))
+		}, wantErr: ErrBlobNotInBlock},
+		{name: "short inclusion proof", modify: func(chain *liveChain) {
+			chain.sidecars[1].CommitmentInclusionProof = chain.sidecars[1].CommitmentInclusionProof[1:]
+		}, wantErr: ErrBlobNotInBlock},
+		{name: "blob not of the block", modify: func(chain *liveChain) {
+			// a self-consistent blob, commitment and proof in place of the blob 0
+			sidecar := other.sidecar("0")
+			sidecar.SignedBeaconBlockHeader = toSignedHeader(header)
+			sidecar.CommitmentInclusionProof = proofs[0]
+			chain.sidecars[0] = sidecar
+		}, wantErr: ErrBlobNotInBlock},
+		{name: "blob not of the commitment", modify: func(chain *liveChain) {
+			chain.sidecars[0].Blob = hexutil.Encode(other.blob[:])
+		}, wantErr: ErrInvalidKZGProof},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := newChain()
+			tt.modify(chain)
+			b := BlobService{chainClient: chain, cfg: &config.ServerConfig{Chain: config.ETH}}
+			sidecars, err := b.getUnarchivedBlobSidecars(context.Background(), 1, tt.indices)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("getUnarchivedBlobSidecars() err=%v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("getUnarchivedBlobSidecars() failed, err=%s", err.Error())
+			}
+			want := 2
+			if len(tt.indices) != 0 {
+				want = len(tt.indices)
+			}
+			if len(sidecars) != want {
+				t.Fatalf("getUnarchivedBlobSidecars() returned %d sidecars, want %d", len(sidecars), want)
+			}
+		})
+	}
+}
+
+func newBlobTx(nonce uint64, blobs ...kzgBlob) *types.Transaction {
+	hashes := make([]common.Hash, len(blobs))
+	for i, blob := range blobs {
+		hashes[i] = kzg4844.CalcBlobHashV1(sha256.New(), &blob.commitment)
+	}
+	return types.NewTx(&types.BlobTx{Nonce: nonce, BlobHashes: hashes})
+}
+
+func TestGetUnarchivedBlobSidecarsBSC(t *testing.T) {
+	blob0, blob1, blob2 := newKZGBlob(t, 0), newKZGBlob(t, 1), newKZGBlob(t, 2)
+	tx0, tx1 := newBlobTx(0, blob0, blob1), newBlobTx(1, blob2)
+	block := types.NewBlockWithHeader(&types.Header{Number: big.NewInt(10)}).
+		WithBody(types.Body{Transactions: []*types.Transaction{types.NewTx(&types.LegacyTx{}), tx0, tx1}})
+	// newChain serves the blobs of the two blob txs of the block
+	newChain := func() *liveChain {
+		chain := &liveChain{block: block}
+		for i, blob := range []kzgBlob{blob0, blob1, blob2} {
+			sidecar := blob.sidecar(util.Int64ToString(int64(i)))
+			sidecar.TxHash = tx0.Hash().Hex()
+			if i == 2 {
+				sidecar.TxIndex, sidecar.TxHash = 2, tx1.Hash().Hex()
+			}
+			chain.sidecars = append(chain.sidecars, sidecar)
+		}
+		return chain
+	}
+	tests := []struct {
+		name    string
+		modify  func(chain *liveChain)
+		wantErr error
+	}{
+		{name: "of the block", modify: func(chain *liveChain) {}},
+		{name: "blobs of a tx out of order", modify: func(chain *liveChain) {
+			chain.sidecars[0], chain.sidecars[1] = chain.sidecars[1], chain.sidecars[0]
+		}, wantErr: ErrBlobNotInBlock},
+		{name: "blob of another tx", modify: func(chain *liveChain) {
+			chain.sidecars[2].TxHash = tx0.Hash().Hex()
+		}, wantErr: ErrBlobNotInBlock},
+		{name: "tx not of the block", modify: func(chain *liveChain) {
+			chain.sidecars[2].TxHash = newBlobTx(2, blob2).Hash().Hex()
+		}, wantErr: ErrBlobNotInBlock},
+		{name: "blob not of the block", modify: func(chain *liveChain) {
+			chain.sidecars[2] = newKZGBlob(t, 3).sidecar("2")
+			chain.sidecars[2].TxHash = tx1.Hash().Hex()
+		}, wantErr: ErrBlobNotInBlock},
+		{name: "blob not of the commitment", modify: func(chain *liveChain) {
+			chain.sidecars[2].Blob = hexutil.Encode(blob0.blob[:])
+		}, wantErr: ErrInvalidKZGProof},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			chain := newChain()
+			tt.modify(chain)
+			b := BlobService{chainClient: chain, cfg: &config.ServerConfig{Chain: config.BSC}}
+			sidecars, err := b.getUnarchivedBlobSidecars(context.Background(), 10, nil)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("getUnarchivedBlobSidecars() err=%v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("getUnarchivedBlobSidecars() failed, err=%s", err.Error())
+			}
+			if len(sidecars) != 3 {
+				t.Fatalf("getUnarchivedBlobSidecars() returned %d sidecars, want 3", len(sidecars))
+			}
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel/attribute"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/tracing"
+)
+
+// GetBlockMeta returns the archived block of the block number or slot
+func (b BlobService) GetBlockMeta(ctx context.Context, blockNumOrSlot uint64) (block *db.Block, err error) {
+	_, span := tracing.StartSpan(ctx, "BlobService.GetBlockMeta")
+	span.SetAttributes(attribute.Int64("block_id", int64(blockNumOrSlot)))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	block, err = b.blobDB.GetBlock(blockNumOrSlot)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, blockNotFoundError(b.blobDB, blockNumOrSlot)
+	}
+	return block, err
+}
+
+// GetSlotByRoot returns the slot of the block of the root, gorm.ErrRecordNotFound is returned when the block is not
+// archived
+func (b BlobService) GetSlotByRoot(ctx context.Context, root string) (slot uint64, err error) {
+	_, span := tracing.StartSpan(ctx, "BlobService.GetSlotByRoot")
+	span.SetAttributes(attribute.String("block_root", root))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	block, err := b.blobDB.GetBlockByRoot(root)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, notFoundError(ReasonBlockNotFound, "block %s%s is not archived", prefixHex, root)
+	}
+	if err != nil {
+		return 0, err
+	}
+	return block.Slot, nil
+}
+
+// GetBundleMeta returns the bundle of the name, gorm.ErrRecordNotFound is returned when no such bundle is created
+func (b BlobService) GetBundleMeta(ctx context.Context, bundleName string) (bundle *db.Bundle, err error) {
+	_, span := tracing.StartSpan(ctx, "BlobService.GetBundleMeta")
+	span.SetAttributes(attribute.String("bundle_name", bundleName))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	bundle, err = b.blobDB.GetBundle(bundleName)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, notFoundError(ReasonBundleNotFound, "bundle %s is not created", bundleName)
+	}
+	return bundle, err
+}
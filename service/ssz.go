@@ -0,0 +1,91 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/prysmaticlabs/prysm/v5/consensus-types/primitives"
+	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
+
+	"github.com/bnb-chain/blob-hub/models"
+	"github.com/bnb-chain/blob-hub/util"
+)
+
+// EncodeBlobSidecarsSSZ encodes the sidecars as the beacon API SSZ response, a List[BlobSidecar, MAX_BLOBS_PER_BLOCK].
+// As BlobSidecar is a fixed size container, the list is the concatenation of the encoded sidecars.
+func EncodeBlobSidecarsSSZ(sidecars []*models.Sidecar) ([]byte, error) {
+	sszBytes := make([]byte, 0)
+	for _, sidecar := range sidecars {
+		sidecarBytes, err := EncodeBlobSidecarSSZ(sidecar)
+		if err != nil {
+			return nil, err
+		}
+		sszBytes = append(sszBytes, sidecarBytes...)
+	}
+	return sszBytes, nil
+}
+
+// EncodeBlobSidecarSSZ encodes a single BlobSidecar
+func EncodeBlobSidecarSSZ(sidecar *models.Sidecar) ([]byte, error) {
+	pbSidecar, err := toProtoBlobSidecar(sidecar)
+	if err != nil {
+		return nil, err
+	}
+	sidecarBytes, err := pbSidecar.MarshalSSZ()
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal blob sidecar %s, err=%s", sidecar.Index, err.Error())
+	}
+	return sidecarBytes, nil
+}
+
+func toProtoBlobSidecar(sidecar *models.Sidecar) (*ethpb.BlobSidecar, error) {
+	if sidecar.SignedBlockHeader == nil || sidecar.SignedBlockHeader.Message == nil {
+		return nil, fmt.Errorf("blob sidecar %s has no block header", sidecar.Index)
+	}
+	message := sidecar.SignedBlockHeader.Message
+	index, err := util.StringToUint64(sidecar.Index)
+	if err != nil {
+		return nil, err
+	}
+	slot, err := util.StringToUint64(message.Slot)
+	if err != nil {
+		return nil, err
+	}
+	proposerIndex, err := util.StringToUint64(message.ProposerIndex)
+	if err != nil {
+		return nil, err
+	}
+	hexFields := []string{sidecar.Blob, sidecar.KzgCommitment, sidecar.KzgProof, message.ParentRoot, message.StateRoot,
+		message.BodyRoot, sidecar.SignedBlockHeader.Signature}
+	decoded := make([][]byte, len(hexFields))
+	for i, field := range hexFields {
+		decoded[i], err = hexutil.Decode(field)
+		if err != nil {
+			return nil, err
+		}
+	}
+	inclusionProof := make([][]byte, len(sidecar.KzgCommitmentInclusionProof))
+	for i, proof := range sidecar.KzgCommitmentInclusionProof {
+		inclusionProof[i], err = hexutil.Decode(proof)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &ethpb.BlobSidecar{
+		Index:         index,
+		Blob:          decoded[0],
+		KzgCommitment: decoded[1],
+		KzgProof:      decoded[2],
+		SignedBlockHeader: &ethpb.SignedBeaconBlockHeader{
+			Header: &ethpb.BeaconBlockHeader{
+				Slot:          primitives.Slot(slot),
+				ProposerIndex: primitives.ValidatorIndex(proposerIndex),
+				ParentRoot:    decoded[3],
+				StateRoot:     decoded[4],
+				BodyRoot:      decoded[5],
+			},
+			Signature: decoded[6],
+		},
+		CommitmentInclusionProof: inclusionProof,
+	}, nil
+}
@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/models"
+	"github.com/bnb-chain/blob-hub/tracing"
+	"github.com/bnb-chain/blob-hub/util"
+)
+
+// streamFetchBatchSize is the number of blobs fetched before they are emitted, it bounds the memory of a stream
+const streamFetchBatchSize = 32
+
+var ErrInvalidStreamRange = errors.New("invalid stream range")
+
+// StreamCursor is the position of a blob in a stream, a stream resumes after the blob of its cursor
+type StreamCursor struct {
+	Slot  uint64
+	Index int
+}
+
+func (c StreamCursor) String() string {
+	return fmt.Sprintf("%d:%d", c.Slot, c.Index)
+}
+
+// ParseStreamCursor parses a cursor of the form "<slot>:<index>"
+func ParseStreamCursor(cursor string) (*StreamCursor, error) {
+	parts := strings.Split(cursor, ":")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("invalid cursor %s", cursor)
+	}
+	slot, err := util.StringToUint64(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid cursor %s", cursor)
+	}
+	index, err := util.StringToInt64(parts[1])
+	if err != nil || index < 0 {
+		return nil, fmt.Errorf("invalid cursor %s", cursor)
+	}
+	return &StreamCursor{Slot: slot, Index: int(index)}, nil
+}
+
+// after tells whether the blob is past the cursor
+func (c *StreamCursor) after(meta *db.Blob) bool {
+	return c == nil || meta.Slot > c.Slot || (meta.Slot == c.Slot && meta.Idx > c.Index)
+}
+
+// StreamBlobs calls fn with the blobs of [from, to] in slot and index order, starting after the cursor if any. The
+// blob metadata is queried once per bundle, the blobs are read through the blob cache like those of the other queries.
+// ErrInvalidStreamRange is returned before fn is called when the range is empty or too large.
+func (b BlobService) StreamBlobs(ctx context.Context, from, to uint64, cursor *StreamCursor, fn func(*models.StreamedBlob) error) (err error) {
+	ctx, span := tracing.StartSpan(ctx, "BlobService.StreamBlobs")
+	span.SetAttributes(attribute.Int64("from", int64(from)), attribute.Int64("to", int64(to)))
+	defer func() { tracing.EndSpan(span, err) }()
+
+	if from > to {
+		return fmt.Errorf("%w, from %d is after to %d", ErrInvalidStreamRange, from, to)
+	}
+	if maxRange := b.cfg.GetMaxStreamRange(); to-from >= maxRange {
+		return fmt.Errorf("%w, the maximum is %d slots or blocks", ErrInvalidStreamRange, maxRange)
+	}
+	if cursor != nil && cursor.Slot > from {
+		from = cursor.Slot
+	}
+	if from > to {
+		return nil
+	}
+
+	_, dbSpan := tracing.StartSpan(ctx, "db.GetBlocksBetween")
+	blocks, err := b.blobDB.GetBlocksBetween(from, to)
+	tracing.EndSpan(dbSpan, err)
+	if err != nil {
+		return err
+	}
+	// the blocks of a bundle are consecutive
+	for start := 0; start < len(blocks); {
+		end := start
+		for end+1 < len(blocks) && blocks[end+1].BundleName == blocks[start].BundleName {
+			end++
+		}
+		if err = b.streamBundle(ctx, blocks[start:end+1], cursor, fn); err != nil {
+			return err
+		}
+		start = end + 1
+	}
+	return nil
+}
+
+// streamBundle emits the blobs of the blocks of a bundle
+func (b BlobService) streamBundle(ctx context.Context, blocks []*db.Block, cursor *StreamCursor, fn func(*models.StreamedBlob) error) error {
+	first, last := blocks[0], blocks[len(blocks)-1]
+	_, dbSpan := tracing.StartSpan(ctx, "db.GetBlobBetweenBlocks")
+	blobMetas, err := b.blobDB.GetBlobBetweenBlocks(first.Slot, last.Slot)
+	tracing.EndSpan(dbSpan, err)
+	if err != nil {
+		return err
+	}
+	sort.Slice(blobMetas, func(i, j int) bool {
+		if blobMetas[i].Slot != blobMetas[j].Slot {
+			return blobMetas[i].Slot < blobMetas[j].Slot
+		}
+		return blobMetas[i].Idx < blobMetas[j].Idx
+	})
+	bySlot := make(map[uint64]*db.Block, len(blocks))
+	for _, block := range blocks {
+		bySlot[block.Slot] = block
+	}
+	metas := make([]*db.Blob, 0, len(blobMetas))
+	for _, meta := range blobMetas {
+		if cursor.after(meta) {
+			metas = append(metas, meta)
+		}
+	}
+
+	for start := 0; start < len(metas); start += streamFetchBatchSize {
+		batch := metas[start:min(start+streamFetchBatchSize, len(metas))]
+		objects := make([]string, len(batch))
+		g, gCtx := errgroup.WithContext(ctx)
+		g.SetLimit(bundleFetchConcurrency)
+		for i, meta := range batch {
+			i, meta := i, meta
+			g.Go(func() error {
+				object, err := b.getBlob(gCtx, bySlot[meta.Slot], meta)
+				if err != nil {
+					return err
+				}
+				objects[i] = object
+				return nil
+			})
+		}
+		if err = g.Wait(); err != nil {
+			return err
+		}
+		for i, meta := range batch {
+			err = fn(&models.StreamedBlob{
+				Cursor:        StreamCursor{Slot: meta.Slot, Index: meta.Idx}.String(),
+				VersionedHash: meta.VersionedHash,
+				Slot:          util.Uint64ToString(meta.Slot),
+				TxHash:        fmt.Sprintf("%s%s", prefixHex, meta.TxHash),
+				Sidecar:       b.toSidecar(bySlot[meta.Slot], meta, objects[i]),
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
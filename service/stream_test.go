@@ -0,0 +1,71 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/bnb-chain/blob-hub/db"
+)
+
+func TestParseStreamCursor(t *testing.T) {
+	tests := []struct {
+		name    string
+		cursor  string
+		want    StreamCursor
+		wantErr bool
+	}{
+		{name: "valid", cursor: "123:4", want: StreamCursor{Slot: 123, Index: 4}},
+		{name: "zero", cursor: "0:0", want: StreamCursor{}},
+		{name: "empty", cursor: "", wantErr: true},
+		{name: "no index", cursor: "123", wantErr: true},
+		{name: "too many parts", cursor: "1:2:3", wantErr: true},
+		{name: "empty slot", cursor: ":1", wantErr: true},
+		{name: "empty index", cursor: "1:", wantErr: true},
+		{name: "negative slot", cursor: "-1:0", wantErr: true},
+		{name: "negative index", cursor: "1:-1", wantErr: true},
+		{name: "not a number", cursor: "a:b", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseStreamCursor(tt.cursor)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseStreamCursor() = %v, want an error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseStreamCursor() failed, err=%s", err.Error())
+			}
+			if *got != tt.want {
+				t.Errorf("ParseStreamCursor() = %v, want %v", *got, tt.want)
+			}
+			if got.String() != tt.cursor {
+				t.Errorf("String() = %s, want %s", got.String(), tt.cursor)
+			}
+		})
+	}
+}
+
+func TestStreamCursorAfter(t *testing.T) {
+	cursor := &StreamCursor{Slot: 10, Index: 1}
+	tests := []struct {
+		name   string
+		cursor *StreamCursor
+		meta   *db.Blob
+		want   bool
+	}{
+		{name: "no cursor", cursor: nil, meta: &db.Blob{Slot: 1, Idx: 0}, want: true},
+		{name: "earlier slot", cursor: cursor, meta: &db.Blob{Slot: 9, Idx: 5}, want: false},
+		{name: "earlier index", cursor: cursor, meta: &db.Blob{Slot: 10, Idx: 0}, want: false},
+		{name: "cursor blob", cursor: cursor, meta: &db.Blob{Slot: 10, Idx: 1}, want: false},
+		{name: "later index", cursor: cursor, meta: &db.Blob{Slot: 10, Idx: 2}, want: true},
+		{name: "later slot", cursor: cursor, meta: &db.Blob{Slot: 11, Idx: 0}, want: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.cursor.after(tt.meta); got != tt.want {
+				t.Errorf("after() = %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package syncer
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+
+	"github.com/bnb-chain/blob-hub/external/cmn"
+	"github.com/bnb-chain/blob-hub/logging"
+)
+
+// backfillBlockHashLoop records the hashes of the BSC blocks archived before schema version 5, so that they can be
+// looked up by hash. It resumes from the blocks still without hash on restart, and stops once none is left.
+func (s *BlobSyncer) backfillBlockHashLoop() {
+	backfillTicker := time.NewTicker(BackfillBlockHashInterval)
+	defer backfillTicker.Stop()
+	backoff := loopRetryPolicy.NewBackoff()
+	total := 0
+	for range backfillTicker.C {
+		filled, err := s.backfillBlockHashes(backfillBlockHashBatchSize)
+		if err != nil {
+			pause := backoff.Next(err)
+			logging.Logger.Errorf("failed to backfill block hashes, class=%s, pause=%s, err=%s", cmn.Classify(err), pause, err.Error())
+			time.Sleep(pause)
+			continue
+		}
+		backoff.Reset()
+		total += filled
+		if filled < backfillBlockHashBatchSize {
+			if total > 0 {
+				logging.Logger.Infof("backfilled the hashes of %d blocks", total)
+			}
+			return
+		}
+	}
+}
+
+// backfillBlockHashes records the hashes of up to limit blocks without hash in slot order, it returns the number of
+// blocks filled
+func (s *BlobSyncer) backfillBlockHashes(limit int) (int, error) {
+	blocks, err := s.blobDao.GetBlocksWithoutHash(limit)
+	if err != nil {
+		return 0, err
+	}
+	for i, block := range blocks {
+		ctx, cancel := context.WithTimeout(context.Background(), RPCTimeout)
+		header, err := s.client.GetBlockHeader(ctx, block.Slot)
+		cancel()
+		if err != nil {
+			return i, err
+		}
+		// block hashes are saved to DB without 0x
+		if err = s.blobDao.UpdateBlockHash(block.Slot, hex.EncodeToString(header.Hash().Bytes())); err != nil {
+			return i, err
+		}
+	}
+	return len(blocks), nil
+}
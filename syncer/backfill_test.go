@@ -0,0 +1,98 @@
+package syncer
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/external"
+)
+
+// headerClient serves the headers of a chain, failing at the block number failAt if set
+type headerClient struct {
+	external.IClient
+	failAt uint64
+}
+
+func (c *headerClient) GetBlockHeader(ctx context.Context, height uint64) (*types.Header, error) {
+	if height == c.failAt {
+		return nil, errors.New("node unavailable")
+	}
+	return testHeader(height), nil
+}
+
+func testHeader(height uint64) *types.Header {
+	return &types.Header{Number: new(big.Int).SetUint64(height), Extra: []byte("bsc")}
+}
+
+func newTestBlobDao(t *testing.T) db.BlobDao {
+	t.Helper()
+	gormDB, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "blob-hub.db")), &gorm.Config{
+		Logger:         logger.Default.LogMode(logger.Silent),
+		TranslateError: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = db.MigrateUp(gormDB, db.LatestSchemaVersion()); err != nil {
+		t.Fatal(err)
+	}
+	return db.NewBlobSvcDB(gormDB)
+}
+
+func TestBackfillBlockHashes(t *testing.T) {
+	blobDao := newTestBlobDao(t)
+	// the blocks archived before the hashes were recorded, but for the latest one
+	for slot := uint64(1); slot <= 5; slot++ {
+		block := &db.Block{Slot: slot}
+		if slot == 5 {
+			block.BlockHash = "recorded"
+		}
+		if err := blobDao.SaveBlockAndBlob(block, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	client := &headerClient{failAt: 3}
+	s := &BlobSyncer{blobDao: blobDao, client: client}
+
+	// a failure keeps the blocks filled before it, the next run resumes from the failed one
+	filled, err := s.backfillBlockHashes(10)
+	if err == nil {
+		t.Fatal("backfillBlockHashes() succeeded with a failing node")
+	}
+	if filled != 2 {
+		t.Fatalf("backfillBlockHashes() filled %d blocks before the failure, want 2", filled)
+	}
+	client.failAt = 0
+	if filled, err = s.backfillBlockHashes(1); err != nil || filled != 1 {
+		t.Fatalf("backfillBlockHashes() = %d, %v, want 1 block filled", filled, err)
+	}
+	if filled, err = s.backfillBlockHashes(10); err != nil || filled != 1 {
+		t.Fatalf("backfillBlockHashes() = %d, %v, want the last block filled", filled, err)
+	}
+	if filled, err = s.backfillBlockHashes(10); err != nil || filled != 0 {
+		t.Fatalf("backfillBlockHashes() = %d, %v, want nothing left", filled, err)
+	}
+
+	for slot := uint64(1); slot <= 4; slot++ {
+		block, err := blobDao.GetBlockByHash(hex.EncodeToString(testHeader(slot).Hash().Bytes()))
+		if err != nil {
+			t.Fatalf("block %d not found by its hash, err=%s", slot, err.Error())
+		}
+		if block.Slot != slot {
+			t.Errorf("block of the hash of %d = %d", slot, block.Slot)
+		}
+	}
+	if block, err := blobDao.GetBlock(5); err != nil || block.BlockHash != "recorded" {
+		t.Errorf("block 5 = %+v, %v, want its recorded hash kept", block, err)
+	}
+}
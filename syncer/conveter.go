@@ -2,6 +2,7 @@ package syncer
 
 import (
 	"encoding/json"
+	"strconv"
 
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
 	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
@@ -22,3 +23,14 @@ func ToBlockAndExecutionPayloadDeneb(blockResp *structs.GetBlockV2Response) (*et
 	}
 	return signedBeaconBlockDeneb.GetBlock(), signedBeaconBlockDeneb.GetBlock().GetBody().GetExecutionPayload(), nil
 }
+
+// SlotFromBlockResponse extracts the slot from GetBlockV2Response regardless of the block version
+func SlotFromBlockResponse(blockResp *structs.GetBlockV2Response) (uint64, error) {
+	message := struct {
+		Slot string `json:"slot"`
+	}{}
+	if err := json.Unmarshal(blockResp.Data.Message, &message); err != nil {
+		return 0, err
+	}
+	return strconv.ParseUint(message.Slot, 10, 64)
+}
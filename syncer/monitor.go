@@ -6,12 +6,14 @@ import (
 
 	"github.com/bnb-chain/blob-hub/logging"
 	"github.com/bnb-chain/blob-hub/metrics"
+	"github.com/bnb-chain/blob-hub/notifier"
 )
 
 func (s *BlobSyncer) monitorQuota() {
 	if s.spClient == nil {
 		return
 	}
+	tracker := newQuotaTracker(&s.config.QuotaAlertConfig)
 	monitorTicket := time.NewTicker(MonitorQuotaInterval)
 	for range monitorTicket.C {
 		quota, err := s.spClient.GetBucketReadQuota(context.Background(), s.getBucketName())
@@ -19,8 +21,72 @@ func (s *BlobSyncer) monitorQuota() {
 			logging.Logger.Errorf("failed to get bucket info from SP, err=%s", err.Error())
 			continue
 		}
-		remaining := quota.ReadQuotaSize + quota.MonthlyFreeQuota + quota.SPFreeReadQuotaSize - quota.ReadConsumedSize - quota.MonthlyFreeConsumedSize - quota.FreeConsumedSize
-		metrics.BucketRemainingQuotaGauge.Set(float64(remaining))
-		logging.Logger.Infof("remaining quota in bytes is %d", remaining)
+		forecast := tracker.evaluate(time.Now(), quota)
+		metrics.BucketRemainingQuotaGauge.Set(float64(forecast.remaining))
+		logging.Logger.Infof("remaining quota in bytes is %d, consumption rate is %.2f bytes/s", forecast.remaining, forecast.rate)
+		s.updateQuotaLevel(forecast)
 	}
 }
+
+func (s *BlobSyncer) monitorDiskSpace() {
+	monitorTicket := time.NewTicker(DiskSpaceCheckInterval)
+	for range monitorTicket.C {
+		s.checkTempDirFreeSpace()
+	}
+}
+
+// monitorSyncLag tracks how far the synced block lags the chain, an event is raised when the lag exceeds the threshold
+func (s *BlobSyncer) monitorSyncLag() {
+	lagging := false
+	monitorTicket := time.NewTicker(MonitorSyncLagInterval)
+	for range monitorTicket.C {
+		headBlockID, err := s.getChainHeadBlockID()
+		if err != nil {
+			logging.Logger.Errorf("failed to get chain head, err=%s", err.Error())
+			continue
+		}
+		latestProcessedBlock, err := s.blobDao.GetLatestProcessedBlock()
+		if err != nil {
+			logging.Logger.Errorf("failed to get latest processed block, err=%s", err.Error())
+			continue
+		}
+		var lag uint64
+		if headBlockID > latestProcessedBlock.Slot {
+			lag = headBlockID - latestProcessedBlock.Slot
+		}
+		metrics.SyncLagGauge.Set(float64(lag))
+
+		threshold := s.config.NotifierConfig.SyncLagThreshold
+		if threshold == 0 {
+			continue
+		}
+		if lag > threshold && !lagging {
+			logging.Logger.Warningf("the synced block %d lags the chain head %d by %d", latestProcessedBlock.Slot, headBlockID, lag)
+			if err = s.notify(notifier.EventSyncLag, &notifier.SyncLagEvent{
+				HeadBlockID:   headBlockID,
+				SyncedBlockID: latestProcessedBlock.Slot,
+				Lag:           lag,
+				Threshold:     threshold,
+			}); err != nil {
+				// raise the event again on the next check
+				logging.Logger.Errorf("failed to record sync lag event, err=%s", err.Error())
+				continue
+			}
+		}
+		lagging = lag > threshold
+	}
+}
+
+// getChainHeadBlockID returns the latest finalized block number of BSC, or the head slot of beacon chain
+func (s *BlobSyncer) getChainHeadBlockID() (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), RPCTimeout)
+	defer cancel()
+	if s.BSCChain() {
+		return s.client.GetFinalizedBlockNum(ctx)
+	}
+	latestBlockResp, err := s.client.GetLatestBeaconBlock(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return SlotFromBlockResponse(latestBlockResp)
+}
@@ -0,0 +1,165 @@
+package syncer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bnb-chain/blob-hub/config"
+	"github.com/bnb-chain/blob-hub/external/cmn"
+	"github.com/bnb-chain/blob-hub/logging"
+	"github.com/bnb-chain/blob-hub/metrics"
+	"github.com/bnb-chain/blob-hub/notifier"
+)
+
+// QuotaLevel is the alert level of the bucket read quota
+type QuotaLevel int32
+
+const (
+	QuotaLevelNormal QuotaLevel = iota
+	QuotaLevelWarning
+	QuotaLevelCritical
+)
+
+func (l QuotaLevel) String() string {
+	switch l {
+	case QuotaLevelNormal:
+		return "normal"
+	case QuotaLevelWarning:
+		return "warning"
+	case QuotaLevelCritical:
+		return "critical"
+	default:
+		return fmt.Sprintf("unknown(%d)", int32(l))
+	}
+}
+
+type quotaSample struct {
+	time     time.Time
+	consumed uint64
+}
+
+// quotaForecast is the outcome of evaluating the read quota of the bucket at a point in time
+type quotaForecast struct {
+	total          uint64
+	remaining      uint64
+	rate           float64   // consumption rate in bytes per second over the forecast window
+	exhaustionTime time.Time // zero if the quota is not forecast to run out before the monthly reset
+	level          QuotaLevel
+}
+
+func (f *quotaForecast) remainingPercent() float64 {
+	if f.total == 0 {
+		return 0
+	}
+	return float64(f.remaining) * 100 / float64(f.total)
+}
+
+// quotaTracker keeps the read quota samples of the bucket within the forecast window, and forecasts when the quota
+// runs out based on the consumption rate over the window.
+type quotaTracker struct {
+	cfg     *config.QuotaAlertConfig
+	samples []quotaSample
+}
+
+func newQuotaTracker(cfg *config.QuotaAlertConfig) *quotaTracker {
+	return &quotaTracker{cfg: cfg}
+}
+
+func (t *quotaTracker) evaluate(now time.Time, quota cmn.QuotaInfo) *quotaForecast {
+	total := quota.ReadQuotaSize + quota.MonthlyFreeQuota + quota.SPFreeReadQuotaSize
+	consumed := quota.ReadConsumedSize + quota.MonthlyFreeConsumedSize + quota.FreeConsumedSize
+	forecast := &quotaForecast{total: total}
+	if total > consumed {
+		forecast.remaining = total - consumed
+	}
+
+	// the consumed quota is reset monthly, samples taken before the reset are not comparable anymore
+	if len(t.samples) > 0 && consumed < t.samples[len(t.samples)-1].consumed {
+		t.samples = t.samples[:0]
+	}
+	t.samples = append(t.samples, quotaSample{time: now, consumed: consumed})
+	// keep the latest sample taken at or before the window start, so the rate covers the whole window
+	windowStart := now.Add(-t.cfg.GetForecastWindow())
+	for len(t.samples) > 2 && !t.samples[1].time.After(windowStart) {
+		t.samples = t.samples[1:]
+	}
+
+	first := t.samples[0]
+	if elapsed := now.Sub(first.time).Seconds(); elapsed > 0 {
+		forecast.rate = float64(consumed-first.consumed) / elapsed
+	}
+	if forecast.rate > 0 {
+		year, month, _ := now.Date()
+		nextReset := time.Date(year, month+1, 1, 0, 0, 0, 0, now.Location())
+		secondsLeft := float64(forecast.remaining) / forecast.rate
+		if secondsLeft < nextReset.Sub(now).Seconds() {
+			forecast.exhaustionTime = now.Add(time.Duration(secondsLeft * float64(time.Second)))
+		}
+	}
+	forecast.level = t.level(now, forecast)
+	return forecast
+}
+
+func (t *quotaTracker) level(now time.Time, forecast *quotaForecast) QuotaLevel {
+	daysLeft := -1.0
+	if !forecast.exhaustionTime.IsZero() {
+		daysLeft = forecast.exhaustionTime.Sub(now).Hours() / 24
+	}
+	percent := forecast.remainingPercent()
+	switch {
+	case percent < t.cfg.GetCriticalRemainingPercent(), daysLeft >= 0 && daysLeft < t.cfg.GetCriticalDaysToExhaustion():
+		return QuotaLevelCritical
+	case percent < t.cfg.GetWarningRemainingPercent(), daysLeft >= 0 && daysLeft < t.cfg.GetWarningDaysToExhaustion():
+		return QuotaLevelWarning
+	default:
+		return QuotaLevelNormal
+	}
+}
+
+// updateQuotaLevel records the latest quota level, and emits an event when the level changes
+func (s *BlobSyncer) updateQuotaLevel(forecast *quotaForecast) {
+	metrics.BucketQuotaConsumptionRateGauge.Set(forecast.rate)
+	if forecast.exhaustionTime.IsZero() {
+		metrics.BucketQuotaExhaustionTimeGauge.Set(0)
+	} else {
+		metrics.BucketQuotaExhaustionTimeGauge.Set(float64(forecast.exhaustionTime.Unix()))
+	}
+	metrics.BucketQuotaAlertLevelGauge.Set(float64(forecast.level))
+
+	prevLevel := QuotaLevel(s.quotaLevel.Swap(int32(forecast.level)))
+	if prevLevel == forecast.level {
+		return
+	}
+	exhaustion := "not before the monthly reset"
+	if !forecast.exhaustionTime.IsZero() {
+		exhaustion = forecast.exhaustionTime.Format(time.RFC3339)
+	}
+	switch forecast.level {
+	case QuotaLevelCritical:
+		logging.Logger.Criticalf("bucket read quota is critical, remaining=%d bytes(%.2f%%), forecast exhaustion=%s, throttle verification=%t",
+			forecast.remaining, forecast.remainingPercent(), exhaustion, s.config.QuotaAlertConfig.ThrottleVerification)
+	case QuotaLevelWarning:
+		logging.Logger.Warningf("bucket read quota is low, remaining=%d bytes(%.2f%%), forecast exhaustion=%s",
+			forecast.remaining, forecast.remainingPercent(), exhaustion)
+	default:
+		logging.Logger.Infof("bucket read quota is back to normal, remaining=%d bytes(%.2f%%)", forecast.remaining, forecast.remainingPercent())
+		return
+	}
+	quotaEvent := &notifier.QuotaEvent{
+		Level:     forecast.level.String(),
+		Remaining: forecast.remaining,
+		Total:     forecast.total,
+		Rate:      forecast.rate,
+	}
+	if !forecast.exhaustionTime.IsZero() {
+		quotaEvent.ExhaustionTime = forecast.exhaustionTime.Unix()
+	}
+	if err := s.notify(notifier.EventQuotaLow, quotaEvent); err != nil {
+		logging.Logger.Errorf("failed to record quota low event, level=%s, err=%s", forecast.level.String(), err.Error())
+	}
+}
+
+// QuotaThrottled returns whether the read-heavy optional work should be skipped to save the bucket read quota
+func (s *BlobSyncer) QuotaThrottled() bool {
+	return s.config.QuotaAlertConfig.ThrottleVerification && QuotaLevel(s.quotaLevel.Load()) == QuotaLevelCritical
+}
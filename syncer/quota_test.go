@@ -0,0 +1,169 @@
+package syncer
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/bnb-chain/blob-hub/config"
+	"github.com/bnb-chain/blob-hub/external/cmn"
+)
+
+var testQuotaAlertConfig = &config.QuotaAlertConfig{
+	WarningRemainingPercent:  20,
+	CriticalRemainingPercent: 5,
+	WarningDaysToExhaustion:  7,
+	CriticalDaysToExhaustion: 2,
+	ForecastWindowInHours:    24,
+}
+
+func TestQuotaTrackerEvaluate(t *testing.T) {
+	const total = 10_000_000
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	type sample struct {
+		time     time.Time
+		consumed uint64
+	}
+	tests := []struct {
+		name           string
+		samples        []sample // evaluated in order, the last one at the time of the forecast
+		wantSamples    int
+		wantFirst      time.Time
+		wantRate       float64
+		wantExhaustion time.Duration // from the last sample, 0 if not forecast before the next reset
+		wantLevel      QuotaLevel
+	}{
+		{
+			name: "samples before the window start pruned but the latest",
+			samples: []sample{
+				{now.Add(-30 * time.Hour), 0}, {now.Add(-25 * time.Hour), 5000}, {now.Add(-20 * time.Hour), 10000},
+				{now, 30000},
+			},
+			wantSamples: 3,
+			wantFirst:   now.Add(-25 * time.Hour),
+			wantRate:    25000.0 / (25 * 3600),
+			wantLevel:   QuotaLevelNormal,
+		},
+		{
+			name: "sample at the window start kept",
+			samples: []sample{
+				{now.Add(-25 * time.Hour), 0}, {now.Add(-24 * time.Hour), 1000}, {now.Add(-time.Hour), 24000}, {now, 25000},
+			},
+			wantSamples: 3,
+			wantFirst:   now.Add(-24 * time.Hour),
+			wantRate:    24000.0 / (24 * 3600),
+			wantLevel:   QuotaLevelNormal,
+		},
+		{
+			name: "samples reset when the consumed quota is reset at the month boundary",
+			samples: []sample{
+				{time.Date(2024, 5, 31, 20, 0, 0, 0, time.UTC), 9_000_000},
+				{time.Date(2024, 5, 31, 23, 0, 0, 0, time.UTC), 9_900_000},
+				{time.Date(2024, 6, 1, 1, 0, 0, 0, time.UTC), 1000},
+				{time.Date(2024, 6, 1, 2, 0, 0, 0, time.UTC), 4600},
+			},
+			wantSamples: 2,
+			wantFirst:   time.Date(2024, 6, 1, 1, 0, 0, 0, time.UTC),
+			wantRate:    1,
+			wantLevel:   QuotaLevelNormal,
+		},
+		{
+			name:        "single sample after the reset has no rate",
+			samples:     []sample{{now.Add(-time.Hour), 9_900_000}, {now, 1000}},
+			wantSamples: 1,
+			wantFirst:   now,
+			wantLevel:   QuotaLevelNormal,
+		},
+		{
+			name:           "exhaustion within the warning days",
+			samples:        []sample{{now.Add(-24 * time.Hour), 5_000_000}, {now, 6_000_000}},
+			wantSamples:    2,
+			wantFirst:      now.Add(-24 * time.Hour),
+			wantRate:       1_000_000.0 / (24 * 3600),
+			wantExhaustion: 4 * 24 * time.Hour,
+			wantLevel:      QuotaLevelWarning,
+		},
+		{
+			name: "exhaustion before the next reset",
+			samples: []sample{
+				{time.Date(2024, 5, 29, 0, 0, 0, 0, time.UTC), 6_000_000},
+				{time.Date(2024, 5, 30, 0, 0, 0, 0, time.UTC), 8_000_000},
+			},
+			wantSamples:    2,
+			wantFirst:      time.Date(2024, 5, 29, 0, 0, 0, 0, time.UTC),
+			wantRate:       2_000_000.0 / (24 * 3600),
+			wantExhaustion: 24 * time.Hour,
+			wantLevel:      QuotaLevelCritical,
+		},
+		{
+			name: "exhaustion after the next reset not forecast",
+			samples: []sample{
+				{time.Date(2024, 5, 29, 0, 0, 0, 0, time.UTC), 5_500_000},
+				{time.Date(2024, 5, 30, 0, 0, 0, 0, time.UTC), 6_000_000},
+			},
+			wantSamples: 2,
+			wantFirst:   time.Date(2024, 5, 29, 0, 0, 0, 0, time.UTC),
+			wantRate:    500_000.0 / (24 * 3600),
+			wantLevel:   QuotaLevelNormal,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tracker := newQuotaTracker(testQuotaAlertConfig)
+			var forecast *quotaForecast
+			for _, s := range tt.samples {
+				forecast = tracker.evaluate(s.time, cmn.QuotaInfo{ReadQuotaSize: total, ReadConsumedSize: s.consumed})
+			}
+			if len(tracker.samples) != tt.wantSamples || !tracker.samples[0].time.Equal(tt.wantFirst) {
+				t.Errorf("samples = %v, want %d from %s", tracker.samples, tt.wantSamples, tt.wantFirst)
+			}
+			if math.Abs(forecast.rate-tt.wantRate) > 1e-9 {
+				t.Errorf("rate = %f, want %f", forecast.rate, tt.wantRate)
+			}
+			last := tt.samples[len(tt.samples)-1].time
+			switch {
+			case tt.wantExhaustion == 0 && !forecast.exhaustionTime.IsZero():
+				t.Errorf("exhaustion time = %s, want none", forecast.exhaustionTime)
+			case tt.wantExhaustion != 0 && (forecast.exhaustionTime.Sub(last)-tt.wantExhaustion).Abs() > time.Second:
+				t.Errorf("exhaustion time = %s, want %s", forecast.exhaustionTime, last.Add(tt.wantExhaustion))
+			}
+			if forecast.level != tt.wantLevel {
+				t.Errorf("level = %s, want %s", forecast.level, tt.wantLevel)
+			}
+		})
+	}
+}
+
+func TestQuotaTrackerLevel(t *testing.T) {
+	now := time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		remaining uint64  // of a total of 100
+		daysLeft  float64 // 0 if not forecast to run out before the next reset
+		want      QuotaLevel
+	}{
+		{name: "plenty left", remaining: 50, want: QuotaLevelNormal},
+		{name: "percent at the warning threshold", remaining: 20, want: QuotaLevelNormal},
+		{name: "percent below the warning threshold", remaining: 19, want: QuotaLevelWarning},
+		{name: "percent below the critical threshold", remaining: 4, want: QuotaLevelCritical},
+		{name: "days at the warning threshold", remaining: 50, daysLeft: 7, want: QuotaLevelNormal},
+		{name: "days below the warning threshold", remaining: 50, daysLeft: 6.5, want: QuotaLevelWarning},
+		{name: "days below the critical threshold", remaining: 50, daysLeft: 1.5, want: QuotaLevelCritical},
+		{name: "critical days over warning percent", remaining: 10, daysLeft: 1.5, want: QuotaLevelCritical},
+		{name: "critical percent over warning days", remaining: 4, daysLeft: 6.5, want: QuotaLevelCritical},
+		{name: "warning percent over far exhaustion", remaining: 10, daysLeft: 20, want: QuotaLevelWarning},
+		{name: "warning days over normal percent", remaining: 90, daysLeft: 3, want: QuotaLevelWarning},
+	}
+	tracker := newQuotaTracker(testQuotaAlertConfig)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forecast := &quotaForecast{total: 100, remaining: tt.remaining}
+			if tt.daysLeft != 0 {
+				forecast.exhaustionTime = now.Add(time.Duration(tt.daysLeft * 24 * float64(time.Hour)))
+			}
+			if got := tracker.level(now, forecast); got != tt.want {
+				t.Errorf("level() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+package syncer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/external/cmn"
+	"github.com/bnb-chain/blob-hub/logging"
+	"github.com/bnb-chain/blob-hub/metrics"
+	"github.com/bnb-chain/blob-hub/types"
+	"github.com/bnb-chain/blob-hub/util"
+)
+
+// reconcileTempDir brings the local temp dir in line with the bundle table after a restart. The syncer might crash
+// between creating a bundle dir, writing blob files and finalizing the bundle, so that
+//  1. bundle files and verify dirs are transient and always removed, they are re-created when needed
+//  2. bundle dirs without a record, or whose bundle is no longer finalizing, are orphans and removed
+//  3. blob files missing or partially written for blocks already saved to DB are fetched again
+//  4. a finalizing bundle other than the current one has all its blocks in DB, so it is uploaded right away
+//
+// Only the entries named after bundles are touched, anything else in the temp dir is left alone.
+//
+// It must be called after LoadProgressAndResume, as it relies on the current bundle detail.
+func (s *BlobSyncer) reconcileTempDir() error {
+	if err := os.MkdirAll(s.config.GetTempDir(), os.ModePerm); err != nil {
+		return err
+	}
+	s.checkTempDirFreeSpace()
+
+	entries, err := os.ReadDir(s.config.GetTempDir())
+	if err != nil {
+		return err
+	}
+	curBundleDirFound := false
+	for _, entry := range entries {
+		name := entry.Name()
+		if !isBundleEntry(entry) {
+			logging.Logger.Warningf("found unexpected entry %s in temp dir, leave it as is", name)
+			continue
+		}
+		switch {
+		case !entry.IsDir():
+			logging.Logger.Infof("removing stale bundle file %s", name)
+			if err = os.Remove(filepath.Join(s.config.GetTempDir(), name)); err != nil {
+				return err
+			}
+		case strings.HasSuffix(name, verifyBundleSuffix):
+			logging.Logger.Infof("removing stale verify dir %s", name)
+			if err = os.RemoveAll(filepath.Join(s.config.GetTempDir(), name)); err != nil {
+				return err
+			}
+		default:
+			if name == s.bundleDetail.name {
+				curBundleDirFound = true
+			}
+			if err = s.reconcileBundleDir(name); err != nil {
+				logging.Logger.Errorf("failed to reconcile bundle dir, bundle=%s, err=%s", name, err.Error())
+				return err
+			}
+		}
+	}
+	if curBundleDirFound {
+		return nil
+	}
+	// the bundle is recorded but its dir is gone, blobs of the processed blocks need to be restored before the sync
+	// loop appends more
+	bundle, err := s.blobDao.GetBundle(s.bundleDetail.name)
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil
+		}
+		return err
+	}
+	if bundle.Status != db.Finalizing {
+		return nil
+	}
+	if err = os.MkdirAll(filepath.Dir(s.getBundleDir(bundle.Name)), os.ModePerm); err != nil {
+		return err
+	}
+	return s.reconcileBundleDir(bundle.Name)
+}
+
+// isBundleEntry tells whether a temp dir entry is a bundle dir, a verify dir or a bundle file written by the syncer
+func isBundleEntry(entry os.DirEntry) bool {
+	name := entry.Name()
+	if entry.IsDir() {
+		name = strings.TrimSuffix(name, verifyBundleSuffix)
+	} else if name = strings.TrimSuffix(name, bundleFileSuffix); name == entry.Name() {
+		return false
+	}
+	_, _, err := types.ParseBundleName(name)
+	return err == nil
+}
+
+func (s *BlobSyncer) reconcileBundleDir(bundleName string) error {
+	bundle, err := s.blobDao.GetBundle(bundleName)
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return err
+		}
+		logging.Logger.Infof("removing orphan bundle dir %s", bundleName)
+		return os.RemoveAll(s.getBundleDir(bundleName))
+	}
+	if bundle.Status != db.Finalizing {
+		logging.Logger.Infof("removing bundle dir %s, the bundle status is %d", bundleName, bundle.Status)
+		return os.RemoveAll(s.getBundleDir(bundleName))
+	}
+
+	// a calibrated bundle is only finalizing when re-uploading was interrupted, some blocks might still refer to the
+	// deprecated bundle. Start the re-uploading over instead of patching it up.
+	if bundle.Calibrated {
+		logging.Logger.Infof("restarting the interrupted re-uploading of bundle %s", bundleName)
+		if err = s.blobDao.UpdateBundleStatus(bundleName, db.Deprecated); err != nil {
+			return err
+		}
+		if err = os.RemoveAll(s.getBundleDir(bundleName)); err != nil {
+			return err
+		}
+		return s.reUploadBundle(bundleName, ReUploadReasonInterrupted)
+	}
+
+	startBlockID, endBlockID, err := types.ParseBundleName(bundleName)
+	if err != nil {
+		return err
+	}
+	blocks, err := s.blobDao.GetBlocksBetween(startBlockID, endBlockID)
+	if err != nil {
+		return err
+	}
+	if err = s.rebuildBundleDir(bundleName, blocks); err != nil {
+		return err
+	}
+	if bundleName == s.bundleDetail.name {
+		// the sync loop keeps appending blobs to the current bundle and finalizes it
+		return nil
+	}
+	if uint64(len(blocks)) != endBlockID-startBlockID+1 {
+		logging.Logger.Warningf("bundle %s is finalizing but only %d blocks of block_id[%d, %d] are in DB, leave it as is",
+			bundleName, len(blocks), startBlockID, endBlockID)
+		return nil
+	}
+	logging.Logger.Infof("resuming the uploading of bundle %s", bundleName)
+	return s.finalizeBundle(bundleName, s.getBundleDir(bundleName), s.getBundleFilePath(bundleName))
+}
+
+// rebuildBundleDir fetches blobs again for the blocks whose blob files are missing or incomplete
+func (s *BlobSyncer) rebuildBundleDir(bundleName string, blocks []*db.Block) error {
+	for _, block := range blocks {
+		if block.BlobCount == 0 || s.blobFilesComplete(bundleName, block) {
+			continue
+		}
+		logging.Logger.Infof("rebuilding blob files of block_id=%d in bundle %s", block.Slot, bundleName)
+		var sideCars []*types.GeneralSideCar
+		err := cmn.DefaultRetryPolicy.Do(context.Background(), func() (err error) {
+			ctx, cancel := context.WithTimeout(context.Background(), RPCTimeout)
+			defer cancel()
+			sideCars, err = s.client.GetBlob(ctx, block.Slot)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		if len(sideCars) != block.BlobCount {
+			return fmt.Errorf("found blob number mismatch at block_id=%d, expected=%d, actual=%d", block.Slot, block.BlobCount, len(sideCars))
+		}
+		if err = s.writeBlobToFile(block.Slot, bundleName, sideCars); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *BlobSyncer) blobFilesComplete(bundleName string, block *db.Block) bool {
+	for i := 0; i < block.BlobCount; i++ {
+		info, err := os.Stat(s.getBlobPath(bundleName, types.GetBlobName(block.Slot, i)))
+		if err != nil || info.Size() != types.BlobFileSize {
+			return false
+		}
+	}
+	return true
+}
+
+// checkTempDirFreeSpace warns when the free disk space of the temp dir drops below the configured threshold
+func (s *BlobSyncer) checkTempDirFreeSpace() {
+	free, err := util.GetFreeDiskSpace(s.config.GetTempDir())
+	if err != nil {
+		logging.Logger.Errorf("failed to get free disk space of temp dir, err=%s", err.Error())
+		return
+	}
+	metrics.TempDirFreeSpaceGauge.Set(float64(free))
+	if free < s.config.GetTempDirMinFreeSpace() {
+		logging.Logger.Warningf("free disk space of temp dir %s is %d bytes, below the threshold %d bytes", s.config.GetTempDir(), free, s.config.GetTempDirMinFreeSpace())
+	}
+}
@@ -0,0 +1,50 @@
+package syncer
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestIsBundleEntry(t *testing.T) {
+	dir := t.TempDir()
+	tests := []struct {
+		name  string
+		isDir bool
+		want  bool
+	}{
+		{name: "blobs_s100_e109", isDir: true, want: true},
+		{name: "blobs_s100_e109_calibrated_1712345678", isDir: true, want: true},
+		{name: "blobs_s100_e109_verify", isDir: true, want: true},
+		{name: "blobs_s100_e109.bundle", want: true},
+		{name: "blobs_s100_e109_calibrated_1712345678.bundle", want: true},
+		{name: "blobs_s100_e109", want: false},
+		{name: "blobs_s100_e109.bundle", isDir: true, want: false},
+		{name: "dry-run", isDir: true, want: false},
+		{name: "blobs", isDir: true, want: false},
+		{name: "local_verify", isDir: true, want: false},
+		{name: "notes.bundle", want: false},
+	}
+	for i, tt := range tests {
+		path := filepath.Join(dir, string(rune('a'+i)), tt.name)
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		var err error
+		if tt.isDir {
+			err = os.Mkdir(path, os.ModePerm)
+		} else {
+			err = os.WriteFile(path, nil, 0o600)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		entries, err := os.ReadDir(filepath.Dir(path))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := isBundleEntry(entries[0]); got != tt.want {
+			t.Errorf("isBundleEntry(%s, dir=%t) = %t, want %t", tt.name, tt.isDir, got, tt.want)
+		}
+	}
+}
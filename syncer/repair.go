@@ -0,0 +1,93 @@
+package syncer
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/logging"
+	"github.com/bnb-chain/blob-hub/metrics"
+	"github.com/bnb-chain/blob-hub/types"
+	"github.com/bnb-chain/blob-hub/util"
+)
+
+const (
+	RepairInterval  = 1 * time.Minute
+	RepairBatchSize = 10
+)
+
+// repairLoop works through the repairs queued by the scanner, either in the background or from the scan command
+func (s *BlobSyncer) repairLoop() {
+	repairTicker := time.NewTicker(RepairInterval)
+	for range repairTicker.C {
+		tasks, err := s.blobDao.GetPendingRepairTasks(RepairBatchSize)
+		if err != nil {
+			logging.Logger.Errorf("failed to get pending repairs, err=%s", err.Error())
+			continue
+		}
+		for _, task := range tasks {
+			s.repair(task)
+		}
+	}
+}
+
+// repair runs a repair through the same path as a re-upload, holding rebuildMu so that it never races with verify
+// on the same bundle. A failed repair stays pending until the max attempts.
+func (s *BlobSyncer) repair(task *db.RepairTask) {
+	s.rebuildMu.Lock()
+	defer s.rebuildMu.Unlock()
+
+	var err error
+	switch task.Kind {
+	case db.RepairFillBlocks:
+		err = s.fillBlocks(task.StartBlockID, task.EndBlockID)
+	case db.RepairRebuildBundle:
+		err = s.rebuildRepairedBundle(task)
+	default:
+		err = fmt.Errorf("unknown repair kind %s", task.Kind)
+	}
+	task.Attempts++
+	task.UpdatedTime = time.Now().Unix()
+	if err != nil {
+		logging.Logger.Errorf("failed to repair, kind=%s, start=%d, end=%d, bundle=%s, attempts=%d, err=%s",
+			task.Kind, task.StartBlockID, task.EndBlockID, task.BundleName, task.Attempts, err.Error())
+		task.LastError = err.Error()
+		result := "retry"
+		if task.Attempts >= s.config.ScanConfig.GetMaxRepairAttempts() {
+			task.Status = db.RepairFailed
+			result = "failed"
+		}
+		metrics.RepairCounter.WithLabelValues(string(task.Kind), result).Inc()
+	} else {
+		logging.Logger.Infof("repaired, kind=%s, start=%d, end=%d, bundle=%s", task.Kind, task.StartBlockID, task.EndBlockID, task.BundleName)
+		task.Status = db.RepairDone
+		task.LastError = ""
+		metrics.RepairCounter.WithLabelValues(string(task.Kind), "done").Inc()
+	}
+	if err = s.blobDao.UpdateRepairTask(task); err != nil {
+		logging.Logger.Errorf("failed to update repair, id=%d, err=%s", task.Id, err.Error())
+	}
+}
+
+// fillBlocks syncs the missing blocks within the range into a new calibrated bundle
+func (s *BlobSyncer) fillBlocks(startBlockID, endBlockID uint64) error {
+	newBundleName := types.GetBundleName(startBlockID, endBlockID) + calibratedBundleInfix + util.Int64ToString(time.Now().Unix())
+	logging.Logger.Infof("filling blocks within block_id[%d, %d] into calibrated bundle %s", startBlockID, endBlockID, newBundleName)
+	return s.rebuildBundle(newBundleName, startBlockID, endBlockID)
+}
+
+// rebuildRepairedBundle re-uploads the bundle, unless no block refers to it anymore, e.g. it has been re-uploaded by
+// verify in the meantime
+func (s *BlobSyncer) rebuildRepairedBundle(task *db.RepairTask) error {
+	blocks, err := s.blobDao.GetBlocksBetween(task.StartBlockID, task.EndBlockID)
+	if err != nil {
+		return err
+	}
+	for _, block := range blocks {
+		if block.BundleName == task.BundleName {
+			return s.reUploadBundle(task.BundleName, ReUploadReasonRepair)
+		}
+	}
+	logging.Logger.Infof("no block refers to bundle %s anymore, skip the repair", task.BundleName)
+	return nil
+}
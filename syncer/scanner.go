@@ -0,0 +1,234 @@
+package syncer
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/blob-hub/db"
+	"github.com/bnb-chain/blob-hub/logging"
+	"github.com/bnb-chain/blob-hub/metrics"
+	"github.com/bnb-chain/blob-hub/types"
+)
+
+type ProblemKind string
+
+const (
+	ProblemMissingBlocks     ProblemKind = "missing_blocks"      // no block is recorded for a range of slots(ETH) or blocks(BSC)
+	ProblemBlobCountMismatch ProblemKind = "blob_count_mismatch" // the BlobCount of a block differs from its number of blob rows
+	ProblemMissingBundle     ProblemKind = "missing_bundle"      // the bundle of a block is not recorded
+	ProblemDeprecatedBundle  ProblemKind = "deprecated_bundle"   // the bundle of a block is deprecated
+)
+
+// Problem is an inconsistency found in the block table
+type Problem struct {
+	Kind         ProblemKind
+	StartBlockID uint64
+	EndBlockID   uint64 // EndBlockID is the same as StartBlockID unless a range of blocks is missing
+	BundleName   string
+	Detail       string
+}
+
+func (p *Problem) String() string {
+	if p.StartBlockID != p.EndBlockID {
+		return fmt.Sprintf("%s within block_id[%d, %d]", p.Kind, p.StartBlockID, p.EndBlockID)
+	}
+	if p.Detail == "" {
+		return fmt.Sprintf("%s at block_id=%d, bundle=%s", p.Kind, p.StartBlockID, p.BundleName)
+	}
+	return fmt.Sprintf("%s at block_id=%d, bundle=%s, %s", p.Kind, p.StartBlockID, p.BundleName, p.Detail)
+}
+
+// Scanner checks the block table for missing blocks, and blocks disagreeing with the blob and bundle tables
+type Scanner struct {
+	blobDao        db.BlobDao
+	batchSize      uint64
+	bundleInterval uint64 // bundleInterval caps the range of a repair, as the blocks are re-uploaded as a bundle
+}
+
+func NewScanner(blobDao db.BlobDao, batchSize, bundleInterval uint64) *Scanner {
+	return &Scanner{
+		blobDao:        blobDao,
+		batchSize:      batchSize,
+		bundleInterval: bundleInterval,
+	}
+}
+
+// GetScanRange returns the range of recorded blocks, from the earliest block to the latest processed one. ok is false
+// when no block has been recorded yet.
+func (sc *Scanner) GetScanRange() (startBlockID, endBlockID uint64, ok bool, err error) {
+	earliestBlock, err := sc.blobDao.GetEarliestBlock()
+	if err != nil {
+		return 0, 0, false, err
+	}
+	latestBlock, err := sc.blobDao.GetLatestProcessedBlock()
+	if err != nil {
+		return 0, 0, false, err
+	}
+	if latestBlock.Id == 0 {
+		return 0, 0, false, nil
+	}
+	return earliestBlock.Slot, latestBlock.Slot, true, nil
+}
+
+// Scan checks the blocks within [startBlockID, endBlockID] batch by batch
+func (sc *Scanner) Scan(startBlockID, endBlockID uint64) ([]*Problem, error) {
+	problems := make([]*Problem, 0)
+	for start := startBlockID; start <= endBlockID; start += sc.batchSize {
+		end := start + sc.batchSize - 1
+		if end > endBlockID || end < start {
+			end = endBlockID
+		}
+		batchProblems, err := sc.ScanBatch(start, end)
+		if err != nil {
+			return nil, err
+		}
+		problems = append(problems, batchProblems...)
+		if end == endBlockID {
+			break
+		}
+	}
+	return problems, nil
+}
+
+// ScanBatch checks the blocks within [startBlockID, endBlockID], the range is supposed to be fully synced
+func (sc *Scanner) ScanBatch(startBlockID, endBlockID uint64) ([]*Problem, error) {
+	blocks, err := sc.blobDao.GetBlocksBetween(startBlockID, endBlockID)
+	if err != nil {
+		return nil, err
+	}
+	blobCounts, err := sc.blobDao.CountBlobsBetweenBlocks(startBlockID, endBlockID)
+	if err != nil {
+		return nil, err
+	}
+	bundles := make(map[string]*db.Bundle)
+	problems := make([]*Problem, 0)
+	nextBlockID := startBlockID
+	for _, block := range blocks {
+		if block.Slot > nextBlockID {
+			problems = append(problems, &Problem{Kind: ProblemMissingBlocks, StartBlockID: nextBlockID, EndBlockID: block.Slot - 1})
+		}
+		nextBlockID = block.Slot + 1
+
+		if blobCounts[block.Slot] != block.BlobCount {
+			problems = append(problems, &Problem{
+				Kind:         ProblemBlobCountMismatch,
+				StartBlockID: block.Slot,
+				EndBlockID:   block.Slot,
+				BundleName:   block.BundleName,
+				Detail:       fmt.Sprintf("blob_count=%d, blob rows=%d", block.BlobCount, blobCounts[block.Slot]),
+			})
+		}
+
+		bundle, ok := bundles[block.BundleName]
+		if !ok {
+			bundle, err = sc.blobDao.GetBundle(block.BundleName)
+			if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, err
+			}
+			bundles[block.BundleName] = bundle
+		}
+		switch {
+		case bundle == nil:
+			problems = append(problems, &Problem{Kind: ProblemMissingBundle, StartBlockID: block.Slot, EndBlockID: block.Slot, BundleName: block.BundleName})
+		case bundle.Status == db.Deprecated:
+			problems = append(problems, &Problem{Kind: ProblemDeprecatedBundle, StartBlockID: block.Slot, EndBlockID: block.Slot, BundleName: block.BundleName})
+		}
+	}
+	if nextBlockID <= endBlockID {
+		problems = append(problems, &Problem{Kind: ProblemMissingBlocks, StartBlockID: nextBlockID, EndBlockID: endBlockID})
+	}
+	return problems, nil
+}
+
+// QueueRepairs queues the repairs of the problems for the syncer, it returns the number of queued repairs. Missing
+// blocks are synced again in ranges of at most a bundle interval, the other problems re-upload the bundle of the block.
+func (sc *Scanner) QueueRepairs(problems []*Problem) (int, error) {
+	now := time.Now().Unix()
+	tasks := make([]*db.RepairTask, 0)
+	rebuiltBundles := make(map[string]struct{})
+	for _, p := range problems {
+		if p.Kind == ProblemMissingBlocks {
+			for start := p.StartBlockID; start <= p.EndBlockID; start += sc.bundleInterval {
+				end := start + sc.bundleInterval - 1
+				if end > p.EndBlockID || end < start {
+					end = p.EndBlockID
+				}
+				tasks = append(tasks, &db.RepairTask{Kind: db.RepairFillBlocks, StartBlockID: start, EndBlockID: end})
+				if end == p.EndBlockID {
+					break
+				}
+			}
+			continue
+		}
+		if _, ok := rebuiltBundles[p.BundleName]; ok {
+			continue
+		}
+		rebuiltBundles[p.BundleName] = struct{}{}
+		startBlockID, endBlockID, err := types.ParseBundleName(p.BundleName)
+		if err != nil {
+			logging.Logger.Errorf("failed to parse bundle name of block %d, bundle=%s, err=%s", p.StartBlockID, p.BundleName, err.Error())
+			continue
+		}
+		tasks = append(tasks, &db.RepairTask{Kind: db.RepairRebuildBundle, StartBlockID: startBlockID, EndBlockID: endBlockID, BundleName: p.BundleName})
+	}
+	queued := 0
+	for _, task := range tasks {
+		task.CreatedTime = now
+		task.UpdatedTime = now
+		created, err := sc.blobDao.CreateRepairTask(task)
+		if err != nil {
+			return queued, err
+		}
+		if created {
+			queued++
+		}
+	}
+	return queued, nil
+}
+
+// scanLoop scans the recorded blocks a batch at each interval, and starts over from the earliest block once it
+// catches up with the latest processed one
+func (s *BlobSyncer) scanLoop() {
+	scanner := NewScanner(s.blobDao, s.config.ScanConfig.GetBatchSize(), s.getCreateBundleInterval())
+	var nextBlockID uint64
+	scanTicker := time.NewTicker(s.config.ScanConfig.GetInterval())
+	for range scanTicker.C {
+		startBlockID, endBlockID, ok, err := scanner.GetScanRange()
+		if err != nil {
+			logging.Logger.Errorf("failed to get the scan range, err=%s", err.Error())
+			continue
+		}
+		if !ok {
+			continue
+		}
+		if nextBlockID < startBlockID || nextBlockID > endBlockID {
+			nextBlockID = startBlockID
+		}
+		batchEndBlockID := nextBlockID + s.config.ScanConfig.GetBatchSize() - 1
+		if batchEndBlockID > endBlockID {
+			batchEndBlockID = endBlockID
+		}
+		problems, err := scanner.ScanBatch(nextBlockID, batchEndBlockID)
+		if err != nil {
+			logging.Logger.Errorf("failed to scan blocks, start=%d, end=%d, err=%s", nextBlockID, batchEndBlockID, err.Error())
+			continue
+		}
+		for _, p := range problems {
+			logging.Logger.Warningf("scanner found %s", p)
+			metrics.ScanProblemCounter.WithLabelValues(string(p.Kind)).Inc()
+		}
+		if len(problems) > 0 && s.config.ScanConfig.AutoRepair {
+			queued, err := scanner.QueueRepairs(problems)
+			if err != nil {
+				logging.Logger.Errorf("failed to queue repairs, err=%s", err.Error())
+				continue
+			}
+			logging.Logger.Infof("queued %d repairs for block_id[%d, %d]", queued, nextBlockID, batchEndBlockID)
+		}
+		metrics.ScannedBlockIDGauge.Set(float64(batchEndBlockID))
+		nextBlockID = batchEndBlockID + 1
+	}
+}
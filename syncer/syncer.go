@@ -10,6 +10,8 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"gorm.io/gorm"
@@ -21,6 +23,7 @@ import (
 	v1 "github.com/prysmaticlabs/prysm/v5/proto/engine/v1"
 	ethpb "github.com/prysmaticlabs/prysm/v5/proto/prysm/v1alpha1"
 	"github.com/prysmaticlabs/prysm/v5/runtime/version"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/bnb-chain/blob-hub/config"
 	"github.com/bnb-chain/blob-hub/db"
@@ -28,6 +31,8 @@ import (
 	"github.com/bnb-chain/blob-hub/external/cmn"
 	"github.com/bnb-chain/blob-hub/external/eth"
 	"github.com/bnb-chain/blob-hub/logging"
+	"github.com/bnb-chain/blob-hub/notifier"
+	"github.com/bnb-chain/blob-hub/tracing"
 	"github.com/bnb-chain/blob-hub/types"
 	"github.com/bnb-chain/blob-hub/util"
 )
@@ -37,15 +42,44 @@ const (
 	BundleStatusCreatedOnChain = 2
 	BundleStatusSealedOnChain  = 3
 
-	LoopSleepTime      = 10 * time.Millisecond
-	LoopErrorPauseTime = 2 * time.Second
-	BSCPauseTime       = 750 * time.Millisecond
+	LoopSleepTime         = 10 * time.Millisecond
+	LoopErrorPauseTime    = 2 * time.Second
+	LoopErrorMaxPauseTime = 2 * time.Minute
+	BSCPauseTime          = 750 * time.Millisecond
 
 	ETHPauseTime         = 90 * time.Second
 	RPCTimeout           = 20 * time.Second
 	MonitorQuotaInterval = 5 * time.Minute
+
+	DiskSpaceCheckInterval = 5 * time.Minute
+	MonitorSyncLagInterval = 1 * time.Minute
+
+	PruneBlockEventInterval = 10 * time.Minute
+	// BackfillBlockHashInterval paces the backfill of the block hashes, which shares the RPC nodes with the sync
+	BackfillBlockHashInterval  = 100 * time.Millisecond
+	backfillBlockHashBatchSize = 100
+
+	bundleFileSuffix      = ".bundle"
+	verifyBundleSuffix    = "_verify"
+	calibratedBundleInfix = "_" + types.CalibratedBundleTag + "_"
 )
 
+func newDryRunBundleSink(cfg *config.DryRunConfig) (cmn.BundleSink, error) {
+	if cfg.Sink == config.DryRunSinkLocal {
+		return cmn.NewLocalBundleSink(cfg.LocalDir)
+	}
+	return cmn.NewNoopBundleSink(), nil
+}
+
+// loopRetryPolicy paces the sync and verify loops after failures, the pause grows from LoopErrorPauseTime on
+// consecutive failures
+var loopRetryPolicy = cmn.RetryPolicy{
+	BaseDelay:  LoopErrorPauseTime,
+	MaxDelay:   LoopErrorMaxPauseTime,
+	Multiplier: 2,
+	Jitter:     0.2,
+}
+
 type curBundleDetail struct {
 	name            string
 	startBlockID    uint64
@@ -56,35 +90,46 @@ type BlobSyncer struct {
 	blobDao      db.BlobDao
 	client       external.IClient
 	bundleClient *cmn.BundleClient
+	bundleSink   cmn.BundleSink // bundleSink is the bundle client, unless in a dry run
 	chainClient  *cmn.ChainClient
 	config       *config.SyncerConfig
 	bundleDetail *curBundleDetail
 	spClient     *cmn.SPClient
 	params       *cmn.VersionedParams
+	quotaLevel   atomic.Int32
+	notifier     *notifier.Notifier
+	rebuildMu    sync.Mutex // rebuildMu serializes verify and repairs, both of which might re-upload a bundle
 }
 
 func NewBlobSyncer(
 	blobDao db.BlobDao,
 	cfg *config.SyncerConfig,
 ) *BlobSyncer {
-	pkBz, err := hex.DecodeString(cfg.PrivateKey)
-	if err != nil {
-		panic(err)
-	}
-	bundleClient, err := cmn.NewBundleClient(cfg.BundleServiceEndpoints[0], cmn.WithPrivateKey(pkBz))
-	if err != nil {
-		panic(err)
-	}
 	chainClient, err := cmn.NewChainClient(cfg.GnfdRpcAddr)
 	if err != nil {
 		panic(err)
 	}
 
 	bs := &BlobSyncer{
-		blobDao:      blobDao,
-		bundleClient: bundleClient,
-		chainClient:  chainClient,
-		config:       cfg,
+		blobDao:     blobDao,
+		chainClient: chainClient,
+		config:      cfg,
+	}
+	if cfg.DryRunConfig.Enable {
+		bs.bundleSink, err = newDryRunBundleSink(&cfg.DryRunConfig)
+		if err != nil {
+			panic(err)
+		}
+	} else {
+		pkBz, err := hex.DecodeString(cfg.PrivateKey)
+		if err != nil {
+			panic(err)
+		}
+		bs.bundleClient, err = cmn.NewBundleClient(cfg.BundleServiceEndpoints[0], cmn.WithPrivateKey(pkBz))
+		if err != nil {
+			panic(err)
+		}
+		bs.bundleSink = bs.bundleClient
 	}
 	bs.client = external.NewClient(cfg)
 	if cfg.MetricsConfig.Enable && len(cfg.MetricsConfig.SPEndpoint) > 0 {
@@ -94,6 +139,10 @@ func NewBlobSyncer(
 		}
 		bs.spClient = spClient
 	}
+	// a dry run is not supposed to alert anyone
+	if cfg.NotifierConfig.Enabled() && !cfg.DryRunConfig.Enable {
+		bs.notifier = notifier.NewNotifier(blobDao, &cfg.NotifierConfig, cfg.Chain, cfg.BucketName)
+	}
 	return bs
 }
 
@@ -108,42 +157,95 @@ func (s *BlobSyncer) StartLoop() {
 		if err != nil {
 			panic(err)
 		}
+		if err = s.reconcileTempDir(); err != nil {
+			panic(err)
+		}
 		syncTicker := time.NewTicker(LoopSleepTime)
+		backoff := loopRetryPolicy.NewBackoff()
 		for range syncTicker.C {
 			if err = s.sync(); err != nil {
-				logging.Logger.Errorf("failed to sync, err=%s", err.Error())
-				time.Sleep(LoopErrorPauseTime)
-			}
-		}
-	}()
-	go func() {
-		verifyTicket := time.NewTicker(LoopSleepTime)
-		for range verifyTicket.C {
-			if err := s.verify(); err != nil {
-				logging.Logger.Errorf("failed to verify, err=%s", err.Error())
-				time.Sleep(LoopErrorPauseTime)
+				pause := backoff.Next(err)
+				logging.Logger.Errorf("failed to sync, class=%s, pause=%s, err=%s", cmn.Classify(err), pause, err.Error())
+				time.Sleep(pause)
+				continue
 			}
+			backoff.Reset()
 		}
 	}()
+	if s.config.DryRunConfig.Enable {
+		logging.Logger.Infof("running dry with the %s bundle sink, verification is disabled", s.config.DryRunConfig.Sink)
+	} else {
+		go s.verifyLoop()
+	}
+	go s.repairLoop()
+	if s.config.ScanConfig.Enable {
+		go s.scanLoop()
+	}
 	go s.monitorQuota()
+	go s.monitorDiskSpace()
+	go s.monitorSyncLag()
+	go s.pruneBlockEvents()
+	if s.BSCChain() {
+		go s.backfillBlockHashLoop()
+	}
+	if s.notifier != nil {
+		s.notifier.Start()
+	}
+}
+
+func (s *BlobSyncer) verifyLoop() {
+	verifyTicket := time.NewTicker(LoopSleepTime)
+	backoff := loopRetryPolicy.NewBackoff()
+	for range verifyTicket.C {
+		s.rebuildMu.Lock()
+		err := s.verify()
+		s.rebuildMu.Unlock()
+		if err != nil {
+			pause := backoff.Next(err)
+			logging.Logger.Errorf("failed to verify, class=%s, pause=%s, err=%s", cmn.Classify(err), pause, err.Error())
+			time.Sleep(pause)
+			continue
+		}
+		backoff.Reset()
+	}
 }
 
-func (s *BlobSyncer) sync() error {
+// pruneBlockEvents deletes the block events older than the retention, the subscribers can't resume from them afterwards
+func (s *BlobSyncer) pruneBlockEvents() {
+	pruneTicker := time.NewTicker(PruneBlockEventInterval)
+	for range pruneTicker.C {
+		deleted, err := s.blobDao.DeleteBlockEventsBefore(time.Now().Add(-s.config.GetBlockEventRetention()).Unix())
+		if err != nil {
+			logging.Logger.Errorf("failed to prune block events, err=%s", err.Error())
+			continue
+		}
+		if deleted > 0 {
+			logging.Logger.Infof("pruned %d block events", deleted)
+		}
+	}
+}
+
+func (s *BlobSyncer) sync() (err error) {
 	var (
 		blockID uint64
-		err     error
 		block   *structs.GetBlockV2Response
 	)
+	spanCtx, span := tracing.StartSpan(context.Background(), "BlobSyncer.sync")
+	defer func() { tracing.EndSpan(span, err) }()
 	blockID, err = s.getNextBlockNumOrSlot()
 	if err != nil {
 		return err
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), RPCTimeout)
+	span.SetAttributes(attribute.Int64("block_id", int64(blockID)))
+	ctx, cancel := context.WithTimeout(spanCtx, RPCTimeout)
 	defer cancel()
 
-	var isForkedBlock bool
+	var (
+		isForkedBlock  bool
+		blockFetchTime time.Duration // the time of fetching the beacon block, the rest is spent in toBlockAndBlobs
+	)
 	if s.BSCChain() {
-		finalizedBlockNum, err := s.client.GetFinalizedBlockNum(context.Background())
+		finalizedBlockNum, err := s.client.GetFinalizedBlockNum(spanCtx)
 		if err != nil {
 			return err
 		}
@@ -153,7 +255,9 @@ func (s *BlobSyncer) sync() error {
 		}
 	} else {
 		var latestBlockResp *structs.GetBlockV2Response
+		fetchStart := time.Now()
 		block, err = s.client.GetBeaconBlock(ctx, blockID)
+		blockFetchTime = time.Since(fetchStart)
 		if err != nil {
 			if !errors.Is(err, eth.ErrBlockNotFound) {
 				return err
@@ -187,12 +291,14 @@ func (s *BlobSyncer) sync() error {
 	var sideCars []*types.GeneralSideCar
 
 	if !isForkedBlock {
-		ctx, cancel = context.WithTimeout(context.Background(), RPCTimeout)
+		ctx, cancel = context.WithTimeout(spanCtx, RPCTimeout)
 		defer cancel()
+		fetchStart := time.Now()
 		sideCars, err = s.client.GetBlob(ctx, blockID)
 		if err != nil {
 			return err
 		}
+		metrics.ObserveStage(metrics.StageSidecarFetch, fetchStart)
 	}
 
 	bundleName := s.bundleDetail.name
@@ -211,16 +317,24 @@ func (s *BlobSyncer) sync() error {
 			return err
 		}
 	} else {
+		convertStart := time.Now()
 		blockToSave, blobToSave, err := s.toBlockAndBlobs(block, sideCars, blockID, bundleName)
 		if err != nil {
 			logging.Logger.Errorf("failed to convert to block and blobs, err=%s", err.Error())
 			return err
 		}
+		metrics.StageDurationHistogram.WithLabelValues(metrics.StageBlockFetch).Observe((blockFetchTime + time.Since(convertStart)).Seconds())
+		saveStart := time.Now()
 		if err = s.blobDao.SaveBlockAndBlob(blockToSave, blobToSave); err != nil {
 			logging.Logger.Errorf("failed to save block(h=%d) to DB, err=%s", blockID, err.Error())
 			return err
 		}
+		metrics.ObserveStage(metrics.StageDBSave, saveStart)
 		logging.Logger.Infof("saved block(block_id=%d) and blobs(num=%d) to DB \n", blockID, len(blobToSave))
+		metrics.ArchivedBlobsCounter.Add(float64(len(sideCars)))
+		for _, sideCar := range sideCars {
+			metrics.ArchivedBytesCounter.Add(float64(len(strings.TrimPrefix(sideCar.Blob, "0x")) / 2))
+		}
 	}
 	metrics.SyncedBlockIDGauge.Set(float64(blockID))
 	// update the block status to processed
@@ -255,10 +369,14 @@ func (s *BlobSyncer) process(bundleName string, blockID uint64, sidecars []*type
 	}
 	if blockID == s.bundleDetail.finalizeBlockID {
 		// this is idempotent
-		_, err = s.bundleClient.GetBundleInfo(s.getBucketName(), bundleName)
+		_, err = s.bundleSink.GetBundleInfo(s.getBucketName(), bundleName)
 		if err == nil {
 			logging.Logger.Infof("bundle %s already exists in bundle service", bundleName)
-			return s.blobDao.UpdateBundleStatus(bundleName, db.Finalized)
+			events, err := s.outboxEvents(notifier.EventBundleFinalized, s.newBundleEvent(bundleName))
+			if err != nil {
+				return err
+			}
+			return s.blobDao.UpdateBundleStatus(bundleName, db.Finalized, events...)
 		}
 		if !errors.Is(err, cmn.ErrorBundleNotExist) {
 			logging.Logger.Errorf("failed to get bundle info, bundle=%s, err=%s", bundleName, err.Error())
@@ -311,16 +429,20 @@ func (s *BlobSyncer) createLocalBundleDir() error {
 			CreatedTime: time.Now().Unix(),
 		})
 }
-func (s *BlobSyncer) finalizeBundle(bundleName, bundleDir, bundleFilePath string) error {
-	err := s.bundleClient.UploadAndFinalizeBundle(bundleName, s.getBucketName(), bundleDir, bundleFilePath)
-	if err != nil {
-		if !strings.Contains(err.Error(), "Object exists") && !strings.Contains(err.Error(), "empty bundle") {
-			return err
-		}
+
+// finalizeBundle uploads the bundle and marks it finalized, the events are recorded along with the bundle finalized one
+func (s *BlobSyncer) finalizeBundle(bundleName, bundleDir, bundleFilePath string, events ...*db.Outbox) error {
+	err := s.bundleSink.UploadAndFinalizeBundle(bundleName, s.getBucketName(), bundleDir, bundleFilePath)
+	if err != nil && !errors.Is(err, cmn.ErrorBundleExists) && !errors.Is(err, cmn.ErrorEmptyBundle) {
+		return err
 	}
 	os.RemoveAll(bundleDir)
 	os.Remove(bundleFilePath)
-	return s.blobDao.UpdateBundleStatus(bundleName, db.Finalized)
+	finalizedEvents, err := s.outboxEvents(notifier.EventBundleFinalized, s.newBundleEvent(bundleName))
+	if err != nil {
+		return err
+	}
+	return s.blobDao.UpdateBundleStatus(bundleName, db.Finalized, append(finalizedEvents, events...)...)
 }
 
 func (s *BlobSyncer) finalizeCurBundle(bundleName string) error {
@@ -346,15 +468,15 @@ func (s *BlobSyncer) writeBlobToFile(slot uint64, bundleName string, blobs []*ty
 }
 
 func (s *BlobSyncer) getBundleDir(bundleName string) string {
-	return fmt.Sprintf("%s/%s/", s.config.TempDir, bundleName)
+	return fmt.Sprintf("%s/%s/", s.config.GetTempDir(), bundleName)
 }
 
 func (s *BlobSyncer) getBlobPath(bundleName, blobName string) string {
-	return fmt.Sprintf("%s/%s/%s", s.config.TempDir, bundleName, blobName)
+	return fmt.Sprintf("%s/%s/%s", s.config.GetTempDir(), bundleName, blobName)
 }
 
 func (s *BlobSyncer) getBundleFilePath(bundleName string) string {
-	return fmt.Sprintf("%s/%s.bundle", s.config.TempDir, bundleName)
+	return fmt.Sprintf("%s/%s%s", s.config.GetTempDir(), bundleName, bundleFileSuffix)
 }
 
 func (s *BlobSyncer) LoadProgressAndResume(nextBlockID uint64) error {
@@ -418,9 +540,14 @@ func (s *BlobSyncer) toBlockAndBlobs(blockResp *structs.GetBlockV2Response, side
 		blobIndex := 0
 		for _, tx := range elBlock.Body().Transactions {
 			if tx.Type() == ethtypes.BlobTxType {
+				from, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(tx.ChainId()), tx)
+				if err != nil {
+					return fmt.Errorf("failed to recover the sender of tx %s, err=%s", tx.Hash().String(), err.Error())
+				}
 				for _, bs := range tx.BlobHashes() {
 					blobsReturn[blobIndex].TxHash = hex.EncodeToString(tx.Hash().Bytes())
 					blobsReturn[blobIndex].ToAddr = tx.To().String()
+					blobsReturn[blobIndex].FromAddr = from.String()
 					blobsReturn[blobIndex].VersionedHash = bs.String()
 					blobIndex++
 				}
@@ -439,6 +566,7 @@ func (s *BlobSyncer) toBlockAndBlobs(blockResp *structs.GetBlockV2Response, side
 		}
 		blockReturn = &db.Block{
 			Root:       hex.EncodeToString(header.Root.Bytes()),
+			BlockHash:  hex.EncodeToString(header.Hash().Bytes()),
 			Slot:       blockNumOrSlot,
 			BlobCount:  len(sidecars),
 			BundleName: bundleName,
@@ -513,6 +641,7 @@ func (s *BlobSyncer) toBlockAndBlobs(blockResp *structs.GetBlockV2Response, side
 				ProposerIndex: uint64(clBlock.ProposerIndex),
 				Slot:          uint64(clBlock.GetSlot()),
 				ELBlockHeight: executionPayload.GetBlockNumber(),
+				BlockHash:     hex.EncodeToString(executionPayload.GetBlockHash()),
 				BlobCount:     len(sidecars),
 				BundleName:    bundleName,
 			}
@@ -554,6 +683,33 @@ func (s *BlobSyncer) ETHChain() bool {
 	return s.config.Chain == config.ETH
 }
 
+// notify records an event coming without a state change for the webhook endpoints, if the notifier is enabled
+func (s *BlobSyncer) notify(eventType notifier.EventType, data interface{}) error {
+	if s.notifier == nil {
+		return nil
+	}
+	return s.notifier.Notify(eventType, data)
+}
+
+// outboxEvents returns the outbox rows of an event, to be saved along with the state change it reports. There is none
+// if the notifier is disabled.
+func (s *BlobSyncer) outboxEvents(eventType notifier.EventType, data interface{}) ([]*db.Outbox, error) {
+	if s.notifier == nil {
+		return nil, nil
+	}
+	return s.notifier.Events(eventType, data)
+}
+
+func (s *BlobSyncer) newBundleEvent(bundleName string) *notifier.BundleEvent {
+	startBlockID, endBlockID, _ := types.ParseBundleName(bundleName)
+	return &notifier.BundleEvent{
+		BundleName:   bundleName,
+		StartBlockID: startBlockID,
+		EndBlockID:   endBlockID,
+		Calibrated:   strings.Contains(bundleName, calibratedBundleInfix),
+	}
+}
+
 func (s *BlobSyncer) GetParams() (*cmn.VersionedParams, error) {
 	if s.params == nil {
 		ctx, cancel := context.WithTimeout(context.Background(), RPCTimeout)
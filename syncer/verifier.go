@@ -14,18 +14,31 @@ import (
 	"gorm.io/gorm"
 
 	"github.com/prysmaticlabs/prysm/v5/api/server/structs"
+	"go.opentelemetry.io/otel/attribute"
 
 	"github.com/bnb-chain/blob-hub/db"
 	"github.com/bnb-chain/blob-hub/external/cmn"
 	"github.com/bnb-chain/blob-hub/external/eth"
 	"github.com/bnb-chain/blob-hub/logging"
 	"github.com/bnb-chain/blob-hub/metrics"
+	"github.com/bnb-chain/blob-hub/notifier"
+	"github.com/bnb-chain/blob-hub/tracing"
 	"github.com/bnb-chain/blob-hub/types"
 	"github.com/bnb-chain/blob-hub/util"
 )
 
 const VerifyPauseTime = 90 * time.Second
 
+// reasons of re-uploading a bundle
+const (
+	ReUploadReasonNotSealed         = "not_sealed"
+	ReUploadReasonChecksumMismatch  = "checksum_mismatch"
+	ReUploadReasonBlobCountMismatch = "blob_count_mismatch"
+	ReUploadReasonBlobMismatch      = "blob_mismatch"
+	ReUploadReasonInterrupted       = "interrupted"
+	ReUploadReasonRepair            = "repair"
+)
+
 var (
 	ErrVerificationFailed = errors.New("verification failed")
 )
@@ -37,7 +50,9 @@ var (
 //  3. verification on a specified blob failed
 //
 // a new bundle should be re-uploaded.
-func (s *BlobSyncer) verify() error {
+func (s *BlobSyncer) verify() (err error) {
+	spanCtx, span := tracing.StartSpan(context.Background(), "BlobSyncer.verify")
+	defer func() { tracing.EndSpan(span, err) }()
 
 	// get the earliest unverified block
 	verifyBlock, err := s.blobDao.GetEarliestUnverifiedBlock()
@@ -50,6 +65,7 @@ func (s *BlobSyncer) verify() error {
 		return err
 	}
 	bundleName := verifyBlock.BundleName
+	span.SetAttributes(attribute.Int64("block_id", int64(verifyBlock.Slot)), attribute.String("bundle_name", bundleName))
 	// check if the bundle has been submitted to bundle service
 	bundle, err := s.blobDao.GetBundle(bundleName)
 	if err != nil {
@@ -87,7 +103,7 @@ func (s *BlobSyncer) verify() error {
 			if err = s.blobDao.UpdateBlocksStatus(bundleStartBlockID, bundleEndBlockID, db.Verified); err != nil {
 				return err
 			}
-			if err = s.blobDao.UpdateBundleStatus(bundleName, db.Sealed); err != nil {
+			if err = s.sealBundle(bundleName); err != nil {
 				return err
 			}
 			return nil
@@ -96,7 +112,7 @@ func (s *BlobSyncer) verify() error {
 		// the bundle is not sealed yet
 		if bundleInfo.Status == BundleStatusFinalized || bundleInfo.Status == BundleStatusCreatedOnChain {
 			// get the object meta from chain
-			objectMeta, err := s.chainClient.GetObjectMeta(context.Background(), s.getBucketName(), bundleName)
+			objectMeta, err := s.chainClient.GetObjectMeta(spanCtx, s.getBucketName(), bundleName)
 			if err != nil {
 				logging.Logger.Errorf("failed to get object meta from chain, bundleName=%s", bundleName)
 				return err
@@ -106,7 +122,7 @@ func (s *BlobSyncer) verify() error {
 			if objectMeta.ObjectStatus != "OBJECT_STATUS_SEALED" {
 				if bundle.CreatedTime > 0 && time.Now().Unix()-bundle.CreatedTime > s.config.GetReUploadBundleThresh() {
 					logging.Logger.Infof("the bundle %s is not sealed and exceed the re-upload threshold %d ", bundleName, s.config.GetReUploadBundleThresh())
-					return s.reUploadBundle(bundleName)
+					return s.reUploadBundle(bundleName, ReUploadReasonNotSealed)
 				}
 				logging.Logger.Info("the bundle is not sealed yet, bundleName=%s, status = %d", bundleName, bundleInfo.Status)
 				return nil
@@ -116,14 +132,19 @@ func (s *BlobSyncer) verify() error {
 
 	// if the detailed integrity check is disabled, verify the bundle integrity
 	if !s.DetailedIntegrityCheckEnabled() {
+		verifyStart := time.Now()
 		err = s.verifyBundleIntegrity(bundleName, bundleStartBlockID, bundleEndBlockID)
 		if err != nil {
 			logging.Logger.Errorf("failed to verify bundle integrity, bundleName=%s, err=%s", bundleName, err.Error())
 			if errors.Is(err, ErrVerificationFailed) {
-				return s.reUploadBundle(bundleName)
+				return s.reUploadFailedBundle(&notifier.VerificationFailedEvent{
+					BundleName: bundleName,
+					Reason:     "bundle checksum mismatch",
+				}, ReUploadReasonChecksumMismatch)
 			}
 			return err
 		}
+		metrics.ObserveStage(metrics.StageVerify, verifyStart)
 		return nil
 	}
 
@@ -134,7 +155,7 @@ func (s *BlobSyncer) verify() error {
 		}
 		if verifyBlockID == bundleEndBlockID {
 			logging.Logger.Debugf("update bundle status to sealed, name=%s , block_id %d ", bundleName, verifyBlockID)
-			if err = s.blobDao.UpdateBundleStatus(bundleName, db.Sealed); err != nil {
+			if err = s.sealBundle(bundleName); err != nil {
 				logging.Logger.Errorf("failed to update bundle status to sealed, name=%s , block_id %d ", bundleName, verifyBlockID)
 				return err
 			}
@@ -143,7 +164,8 @@ func (s *BlobSyncer) verify() error {
 	}
 
 	// get blob from beacon chain or BSC again
-	ctx, cancel := context.WithTimeout(context.Background(), RPCTimeout)
+	verifyStart := time.Now()
+	ctx, cancel := context.WithTimeout(spanCtx, RPCTimeout)
 	defer cancel()
 	sideCars, err := s.client.GetBlob(ctx, verifyBlockID)
 	if err != nil {
@@ -159,17 +181,26 @@ func (s *BlobSyncer) verify() error {
 
 	if len(blobMetas) != len(sideCars) {
 		logging.Logger.Errorf("found blob number mismatch at block_id=%d, bundleName=%s, expected=%d, actual=%d", verifyBlockID, bundleName, len(sideCars), len(blobMetas))
-		return s.reUploadBundle(bundleName)
+		return s.reUploadFailedBundle(&notifier.VerificationFailedEvent{
+			BundleName: bundleName,
+			BlockID:    verifyBlockID,
+			Reason:     "blob number mismatch",
+		}, ReUploadReasonBlobCountMismatch)
 	}
 
 	// verify the blob
-	err = s.verifyBlobsAtBlock(verifyBlockID, sideCars, blobMetas, bundleName)
+	err = s.verifyBlobsAtBlock(spanCtx, verifyBlockID, sideCars, blobMetas, bundleName)
 	if err != nil {
 		if errors.Is(err, ErrVerificationFailed) {
-			return s.reUploadBundle(bundleName)
+			return s.reUploadFailedBundle(&notifier.VerificationFailedEvent{
+				BundleName: bundleName,
+				BlockID:    verifyBlockID,
+				Reason:     "blob mismatch",
+			}, ReUploadReasonBlobMismatch)
 		}
 		return err
 	}
+	metrics.ObserveStage(metrics.StageVerify, verifyStart)
 	// update the status
 	if err = s.blobDao.UpdateBlockStatus(verifyBlockID, db.Verified); err != nil {
 		logging.Logger.Errorf("failed to update block status to verified, block_id=%d err=%s", verifyBlockID, err.Error())
@@ -178,7 +209,7 @@ func (s *BlobSyncer) verify() error {
 	metrics.VerifiedBlockIDGauge.Set(float64(verifyBlockID))
 	if bundleEndBlockID == verifyBlockID {
 		logging.Logger.Debugf("update bundle status to sealed, name=%s , block_id=%d ", bundleName, verifyBlockID)
-		if err = s.blobDao.UpdateBundleStatus(bundleName, db.Sealed); err != nil {
+		if err = s.sealBundle(bundleName); err != nil {
 			logging.Logger.Errorf("failed to update bundle status to sealed, name=%s, block_id %d ", bundleName, verifyBlockID)
 			return err
 		}
@@ -191,7 +222,7 @@ func (s *BlobSyncer) verify() error {
 // If the checksums are not equal, the bundle will be re-uploaded, and the re-uploaded bundle will be verified as well, until the verification is successful.
 func (s *BlobSyncer) verifyBundleIntegrity(bundleName string, bundleStartBlockID, bundleEndBlockID uint64) error {
 	// recreate the bundle for the block range
-	verifyBundleName := bundleName + "_verify"
+	verifyBundleName := bundleName + verifyBundleSuffix
 	_, err := os.Stat(s.getBundleDir(verifyBundleName))
 	if os.IsNotExist(err) {
 		err = os.MkdirAll(filepath.Dir(s.getBundleDir(verifyBundleName)), os.ModePerm)
@@ -256,17 +287,17 @@ func (s *BlobSyncer) verifyBundleIntegrity(bundleName string, bundleStartBlockID
 		return err
 	}
 	metrics.VerifiedBlockIDGauge.Set(float64(bundleEndBlockID))
-	if err = s.blobDao.UpdateBundleStatus(bundleName, db.Sealed); err != nil {
+	if err = s.sealBundle(bundleName); err != nil {
 		return err
 	}
 	logging.Logger.Infof("successfully verify bundle=%s, start_block_id=%d, end_block_id =%d ", bundleName, bundleStartBlockID, bundleEndBlockID)
 	return nil
 }
 
-func (s *BlobSyncer) verifyBlobsAtBlock(blockID uint64, sidecars []*types.GeneralSideCar, blobMetas []*db.Blob, bundleName string) error {
+func (s *BlobSyncer) verifyBlobsAtBlock(ctx context.Context, blockID uint64, sidecars []*types.GeneralSideCar, blobMetas []*db.Blob, bundleName string) error {
 	for i := 0; i < len(sidecars); i++ {
 		// get blob from bundle service
-		blobFromBundle, err := s.bundleClient.GetObject(s.getBucketName(), bundleName, types.GetBlobName(blockID, i))
+		blobFromBundle, err := s.bundleClient.GetObject(ctx, s.getBucketName(), bundleName, types.GetBlobName(blockID, i))
 		if err != nil {
 			if errors.Is(err, cmn.ErrorBundleObjectNotExist) {
 				logging.Logger.Errorf("the bundle object not found in bundle service, object=%s", types.GetBlobName(blockID, i))
@@ -317,21 +348,44 @@ func (s *BlobSyncer) verifyBlobsAtBlock(blockID uint64, sidecars []*types.Genera
 	return nil
 }
 
-// reUploadBundle is used to re-upload a bundle if the verification failed.
-func (s *BlobSyncer) reUploadBundle(bundleName string) error {
-	if err := s.blobDao.UpdateBundleStatus(bundleName, db.Deprecated); err != nil {
+// reUploadBundle is used to re-upload a bundle if the verification failed, the events are recorded along with the
+// bundle deprecated.
+func (s *BlobSyncer) reUploadBundle(bundleName, reason string, events ...*db.Outbox) error {
+	if err := s.blobDao.UpdateBundleStatus(bundleName, db.Deprecated, events...); err != nil {
 		return err
 	}
+	metrics.ReUploadCounter.WithLabelValues(reason).Inc()
 	parts := strings.Split(bundleName, "_")
-	newBundleName := parts[0] + "_" + parts[1] + "_" + parts[2] + "_calibrated_" + util.Int64ToString(time.Now().Unix())
+	newBundleName := parts[0] + "_" + parts[1] + "_" + parts[2] + calibratedBundleInfix + util.Int64ToString(time.Now().Unix())
 
 	startBlockID, endBlockID, err := types.ParseBundleName(bundleName)
 	if err != nil {
 		return err
 	}
 	logging.Logger.Infof("creating new calibrated bundle %s", newBundleName)
+	calibratedEvent := s.newBundleEvent(newBundleName)
+	calibratedEvent.DeprecatedBundleName = bundleName
+	calibratedEvents, err := s.outboxEvents(notifier.EventBundleCalibrated, calibratedEvent)
+	if err != nil {
+		return err
+	}
+	return s.rebuildBundle(newBundleName, startBlockID, endBlockID, calibratedEvents...)
+}
 
-	_, err = os.Stat(s.getBundleDir(newBundleName))
+// reUploadFailedBundle re-uploads a bundle failing the verification, and records the verification failed event
+func (s *BlobSyncer) reUploadFailedBundle(event *notifier.VerificationFailedEvent, reason string) error {
+	events, err := s.outboxEvents(notifier.EventVerificationFailed, event)
+	if err != nil {
+		return err
+	}
+	return s.reUploadBundle(event.BundleName, reason, events...)
+}
+
+// rebuildBundle fetches the blocks within [startBlockID, endBlockID] from beacon chain or BSC again, saves them to DB
+// under the new calibrated bundle, whether they are recorded before or not, and uploads the bundle. The events are
+// recorded once the bundle is finalized.
+func (s *BlobSyncer) rebuildBundle(newBundleName string, startBlockID, endBlockID uint64, events ...*db.Outbox) error {
+	_, err := os.Stat(s.getBundleDir(newBundleName))
 	if os.IsNotExist(err) {
 		err = os.MkdirAll(filepath.Dir(s.getBundleDir(newBundleName)), os.ModePerm)
 		if err != nil {
@@ -362,48 +416,69 @@ func (s *BlobSyncer) reUploadBundle(bundleName string) error {
 		var block *structs.GetBlockV2Response
 		if s.ETHChain() {
 			block, err = s.client.GetBeaconBlock(ctx, bi)
-			if err != nil {
-				if errors.Is(err, eth.ErrBlockNotFound) {
-					continue
-				}
+			if err != nil && !errors.Is(err, eth.ErrBlockNotFound) {
 				return err
 			}
 		}
 
 		blockMeta, err := s.blobDao.GetBlock(bi)
-		if err != nil {
+		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 			return err
 		}
 		blobMetas, err := s.blobDao.GetBlobByBlockID(bi)
 		if err != nil {
 			return err
 		}
-		blockToSave, blobToSave, err := s.toBlockAndBlobs(block, sideCars, bi, newBundleName)
-		if err != nil {
-			return err
+		var (
+			blockToSave *db.Block
+			blobToSave  []*db.Blob
+		)
+		if s.ETHChain() && block == nil {
+			// a forked or missed slot is saved without blobs, the same as in sync
+			blockToSave = &db.Block{Slot: bi, BundleName: newBundleName}
+		} else {
+			blockToSave, blobToSave, err = s.toBlockAndBlobs(block, sideCars, bi, newBundleName)
+			if err != nil {
+				return err
+			}
 		}
-		blockToSave.Id = blockMeta.Id
+		if blockMeta != nil {
+			blockToSave.Id = blockMeta.Id
+		}
+		staleBlobIDs := make([]int64, 0)
 		for i, preBlob := range blobMetas {
 			if i < len(blobToSave) {
 				blobToSave[i].Id = preBlob.Id
+			} else {
+				staleBlobIDs = append(staleBlobIDs, preBlob.Id)
 			}
 		}
-		err = s.blobDao.SaveBlockAndBlob(blockToSave, blobToSave)
+		err = s.blobDao.ReplaceBlockAndBlob(blockToSave, blobToSave, staleBlobIDs)
 		if err != nil {
 			logging.Logger.Errorf("failed to save block(h=%d) and Blob(count=%d), err=%s", blockToSave.Slot, len(blobToSave), err.Error())
 			return err
 		}
 		logging.Logger.Infof("save calibrated block(block_id=%d) and blobs(num=%d) to DB \n", bi, len(blobToSave))
 	}
-	if err = s.finalizeBundle(newBundleName, s.getBundleDir(newBundleName), s.getBundleFilePath(newBundleName)); err != nil {
+	if err = s.finalizeBundle(newBundleName, s.getBundleDir(newBundleName), s.getBundleFilePath(newBundleName), events...); err != nil {
 		logging.Logger.Errorf("failed to finalized bundle, name=%s, err=%s", newBundleName, err.Error())
 		return err
 	}
 	return nil
 }
 
+// sealBundle marks the bundle sealed once all its blocks are verified
+func (s *BlobSyncer) sealBundle(bundleName string) error {
+	events, err := s.outboxEvents(notifier.EventBundleSealed, s.newBundleEvent(bundleName))
+	if err != nil {
+		return err
+	}
+	return s.blobDao.UpdateBundleStatus(bundleName, db.Sealed, events...)
+}
+
 // DetailedIntegrityCheckEnabled returns whether the detailed integrity check on individual blob is enabled, otherwise the
-// integrity check will be done on the bundle level.
+// integrity check will be done on the bundle level. The detailed check reads every blob from bundle service, it is
+// skipped while the bucket read quota is throttled.
 func (s *BlobSyncer) DetailedIntegrityCheckEnabled() bool {
-	return s.config.EnableIndivBlobVerification
+	return s.config.EnableIndivBlobVerification && !s.QuotaThrottled()
 }
@@ -0,0 +1,77 @@
+package tracing
+
+import (
+	"errors"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+const gormSpanKey = "tracing:span"
+
+// GormPlugin traces every DB statement as a span, a child of the span in the statement context if any. The DAO methods
+// which don't take a context, like those of the syncer, are traced as root spans.
+type GormPlugin struct{}
+
+func NewGormPlugin() gorm.Plugin {
+	return &GormPlugin{}
+}
+
+func (p *GormPlugin) Name() string {
+	return "tracing"
+}
+
+// Initialize wraps the callback chains, a span is named after its chain, e.g. gorm.query
+func (p *GormPlugin) Initialize(db *gorm.DB) error {
+	callbacks := db.Callback()
+	for _, err := range []error{
+		callbacks.Create().Before("gorm:create").Register("tracing:before_create", startGormSpan("create")),
+		callbacks.Create().After("gorm:create").Register("tracing:after_create", endGormSpan),
+		callbacks.Query().Before("gorm:query").Register("tracing:before_query", startGormSpan("query")),
+		callbacks.Query().After("gorm:query").Register("tracing:after_query", endGormSpan),
+		callbacks.Update().Before("gorm:update").Register("tracing:before_update", startGormSpan("update")),
+		callbacks.Update().After("gorm:update").Register("tracing:after_update", endGormSpan),
+		callbacks.Delete().Before("gorm:delete").Register("tracing:before_delete", startGormSpan("delete")),
+		callbacks.Delete().After("gorm:delete").Register("tracing:after_delete", endGormSpan),
+		callbacks.Row().Before("gorm:row").Register("tracing:before_row", startGormSpan("row")),
+		callbacks.Row().After("gorm:row").Register("tracing:after_row", endGormSpan),
+		callbacks.Raw().Before("gorm:raw").Register("tracing:before_raw", startGormSpan("raw")),
+		callbacks.Raw().After("gorm:raw").Register("tracing:after_raw", endGormSpan),
+	} {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func startGormSpan(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		ctx, span := StartSpan(db.Statement.Context, "gorm."+operation)
+		db.Statement.Context = ctx
+		db.InstanceSet(gormSpanKey, span)
+	}
+}
+
+func endGormSpan(db *gorm.DB) {
+	value, ok := db.InstanceGet(gormSpanKey)
+	if !ok {
+		return
+	}
+	span, ok := value.(trace.Span)
+	if !ok {
+		return
+	}
+	span.SetAttributes(
+		semconv.DBSystemKey.String(db.Dialector.Name()),
+		semconv.DBCollectionNameKey.String(db.Statement.Table),
+		semconv.DBQueryTextKey.String(db.Statement.SQL.String()),
+	)
+	err := db.Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// a record not found is an answer rather than a failure
+		err = nil
+	}
+	EndSpan(span, err)
+}
@@ -0,0 +1,177 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/bnb-chain/blob-hub/config"
+)
+
+// collector stands in for an OTLP/HTTP collector, it keeps the bodies of the export requests
+type collector struct {
+	mu     sync.Mutex
+	bodies [][]byte
+}
+
+func (c *collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.URL.Path != "/v1/traces" {
+		http.NotFound(w, r)
+		return
+	}
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	c.mu.Lock()
+	c.bodies = append(c.bodies, body)
+	c.mu.Unlock()
+	w.Header().Set("Content-Type", "application/x-protobuf")
+	w.WriteHeader(http.StatusOK)
+}
+
+func (c *collector) received(spanName string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, body := range c.bodies {
+		// the span names are plain strings in the protobuf encoded request
+		if bytes.Contains(body, []byte(spanName)) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestShutdownFlushesSpans(t *testing.T) {
+	c := &collector{}
+	server := httptest.NewServer(c)
+	defer server.Close()
+	defer otel.SetTracerProvider(otel.GetTracerProvider())
+
+	shutdown, err := Init(&config.TracingConfig{
+		Enable:   true,
+		Endpoint: strings.TrimPrefix(server.URL, "http://"),
+		Insecure: true,
+	}, ServiceNameSyncer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, span := StartSpan(context.Background(), "BlobSyncer.test")
+	EndSpan(span, nil)
+	// the batcher exports every few seconds, only the shutdown gets the span out right away
+	if c.received("BlobSyncer.test") {
+		t.Fatal("the span is exported before the shutdown")
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err = shutdown(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if !c.received("BlobSyncer.test") {
+		t.Fatal("the span is not exported on shutdown")
+	}
+	if !c.received(ServiceNameSyncer) {
+		t.Fatal("the service name is not exported")
+	}
+}
+
+func TestInitDisabled(t *testing.T) {
+	shutdown, err := Init(&config.TracingConfig{}, ServiceNameSyncer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = shutdown(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+}
+
+type testBlock struct {
+	Id   int64
+	Slot uint64 `gorm:"uniqueIndex"`
+}
+
+func TestGormPlugin(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	defer otel.SetTracerProvider(otel.GetTracerProvider())
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	db, err := gorm.Open(sqlite.Open(filepath.Join(t.TempDir(), "tracing.db")), &gorm.Config{
+		Logger:         logger.Default.LogMode(logger.Silent),
+		TranslateError: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = db.AutoMigrate(&testBlock{}); err != nil {
+		t.Fatal(err)
+	}
+	if err = db.Use(NewGormPlugin()); err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, parent := StartSpan(context.Background(), "BlobSyncer.sync")
+	if err = db.WithContext(ctx).Create(&testBlock{Slot: 1}).Error; err != nil {
+		t.Fatal(err)
+	}
+	parent.End()
+	if err = db.Create(&testBlock{Slot: 1}).Error; !errors.Is(err, gorm.ErrDuplicatedKey) {
+		t.Fatalf("err = %v, want a duplicate key error", err)
+	}
+	if err = db.Where("slot = ?", 2).Take(&testBlock{}).Error; !errors.Is(err, gorm.ErrRecordNotFound) {
+		t.Fatalf("err = %v, want record not found", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 4 {
+		t.Fatalf("got %d spans, want 4", len(spans))
+	}
+	tests := []struct {
+		name       string
+		child      bool
+		statusCode codes.Code
+	}{
+		{name: "gorm.create", child: true, statusCode: codes.Unset},
+		{name: "BlobSyncer.sync", statusCode: codes.Unset},
+		{name: "gorm.create", statusCode: codes.Error},
+		{name: "gorm.query", statusCode: codes.Unset},
+	}
+	for i, tt := range tests {
+		span := spans[i]
+		if span.Name() != tt.name {
+			t.Errorf("span %d is %s, want %s", i, span.Name(), tt.name)
+		}
+		if child := span.Parent().SpanID() == parent.SpanContext().SpanID(); child != tt.child {
+			t.Errorf("span %d child of the parent = %t, want %t", i, child, tt.child)
+		}
+		if span.Status().Code != tt.statusCode {
+			t.Errorf("span %d status = %s, want %s", i, span.Status().Code, tt.statusCode)
+		}
+		if tt.name == "BlobSyncer.sync" {
+			continue
+		}
+		attrs := make(map[string]string)
+		for _, attr := range span.Attributes() {
+			attrs[string(attr.Key)] = attr.Value.Emit()
+		}
+		if attrs["db.system"] != "sqlite" || attrs["db.collection.name"] != "test_blocks" || attrs["db.query.text"] == "" {
+			t.Errorf("span %d attributes = %v", i, attrs)
+		}
+	}
+}
@@ -6,14 +6,25 @@ import (
 	"strings"
 )
 
-const RootLength = 32
+const (
+	RootLength          = 32
+	VersionedHashLength = 32
+
+	// BlobFileSize is the size of a blob file written by the syncer, a 0x-prefixed hex string of a 128KB blob
+	BlobFileSize = 2 + 2*131072
+
+	// BundleNamePrefix is the prefix of the bundle names, and CalibratedBundleTag tags the bundles re-uploaded after
+	// calibration, blobs_s{start}_e{end}_calibrated_{timestamp}
+	BundleNamePrefix    = "blobs_"
+	CalibratedBundleTag = "calibrated"
+)
 
 func GetBlobName(slot uint64, index int) string {
 	return fmt.Sprintf("blob_h%d_i%d", slot, index)
 }
 
 func GetBundleName(startSlot, endSlot uint64) string {
-	return fmt.Sprintf("blobs_s%d_e%d", startSlot, endSlot)
+	return fmt.Sprintf("%ss%d_e%d", BundleNamePrefix, startSlot, endSlot)
 }
 
 func ParseBlobName(blobName string) (slot uint64, index uint64, err error) {
@@ -29,8 +40,22 @@ func ParseBlobName(blobName string) (slot uint64, index uint64, err error) {
 	return
 }
 
+// ParseBundleName parses the block range of a bundle name, blobs_s{start}_e{end} or its calibrated form
+// blobs_s{start}_e{end}_calibrated_{timestamp}
 func ParseBundleName(bundleName string) (startSlot, endSlot uint64, err error) {
 	parts := strings.Split(bundleName, "_")
+	if !strings.HasPrefix(bundleName, BundleNamePrefix) || (len(parts) != 3 && len(parts) != 5) ||
+		!strings.HasPrefix(parts[1], "s") || !strings.HasPrefix(parts[2], "e") {
+		return 0, 0, fmt.Errorf("invalid bundle name %s", bundleName)
+	}
+	if len(parts) == 5 {
+		if parts[3] != CalibratedBundleTag {
+			return 0, 0, fmt.Errorf("invalid bundle name %s", bundleName)
+		}
+		if _, err = strconv.ParseInt(parts[4], 10, 64); err != nil {
+			return 0, 0, fmt.Errorf("invalid bundle name %s", bundleName)
+		}
+	}
 	startSlot, err = strconv.ParseUint(parts[1][1:], 10, 64)
 	if err != nil {
 		return
@@ -0,0 +1,44 @@
+package types
+
+import "testing"
+
+func TestParseBundleName(t *testing.T) {
+	tests := []struct {
+		name      string
+		bundle    string
+		wantStart uint64
+		wantEnd   uint64
+		wantErr   bool
+	}{
+		{name: "bundle", bundle: "blobs_s100_e109", wantStart: 100, wantEnd: 109},
+		{name: "calibrated bundle", bundle: "blobs_s100_e109_calibrated_1712345678", wantStart: 100, wantEnd: 109},
+		{name: "generated", bundle: GetBundleName(8, 9), wantStart: 8, wantEnd: 9},
+		{name: "empty", bundle: "", wantErr: true},
+		{name: "unrelated dir", bundle: "dry-run", wantErr: true},
+		{name: "no prefix", bundle: "s100_e109", wantErr: true},
+		{name: "other prefix", bundle: "block_s100_e109", wantErr: true},
+		{name: "missing end", bundle: "blobs_s100", wantErr: true},
+		{name: "non numeric start", bundle: "blobs_sx_e109", wantErr: true},
+		{name: "swapped tags", bundle: "blobs_e100_s109", wantErr: true},
+		{name: "unknown infix", bundle: "blobs_s100_e109_copy_1", wantErr: true},
+		{name: "non numeric timestamp", bundle: "blobs_s100_e109_calibrated_x", wantErr: true},
+		{name: "extra part", bundle: "blobs_s100_e109_x", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, err := ParseBundleName(tt.bundle)
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("ParseBundleName(%q) = (%d, %d), want an error", tt.bundle, start, end)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ParseBundleName(%q) failed, err=%s", tt.bundle, err.Error())
+			}
+			if start != tt.wantStart || end != tt.wantEnd {
+				t.Fatalf("ParseBundleName(%q) = (%d, %d), want (%d, %d)", tt.bundle, start, end, tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
@@ -0,0 +1,14 @@
+//go:build !windows
+
+package util
+
+import "syscall"
+
+// GetFreeDiskSpace returns the number of bytes available to an unprivileged user on the file system holding path
+func GetFreeDiskSpace(path string) (uint64, error) {
+	var stat syscall.Statfs_t
+	if err := syscall.Statfs(path, &stat); err != nil {
+		return 0, err
+	}
+	return uint64(stat.Bavail) * uint64(stat.Bsize), nil
+}
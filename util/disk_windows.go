@@ -0,0 +1,18 @@
+//go:build windows
+
+package util
+
+import "golang.org/x/sys/windows"
+
+// GetFreeDiskSpace returns the number of bytes available to the caller on the volume holding path
+func GetFreeDiskSpace(path string) (uint64, error) {
+	pathPtr, err := windows.UTF16PtrFromString(path)
+	if err != nil {
+		return 0, err
+	}
+	var freeBytesAvailable uint64
+	if err = windows.GetDiskFreeSpaceEx(pathPtr, &freeBytesAvailable, nil, nil); err != nil {
+		return 0, err
+	}
+	return freeBytesAvailable, nil
+}
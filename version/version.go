@@ -0,0 +1,25 @@
+package version
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// set by the ldflags of the Makefile
+var (
+	AppVersion    = "dev"
+	GitCommit     = ""
+	GitCommitDate = ""
+)
+
+// NodeVersion returns the version reported by /eth/v1/node/version, in the format of the beacon nodes
+func NodeVersion() string {
+	commit := GitCommit
+	if len(commit) > 7 {
+		commit = commit[:7]
+	}
+	if commit == "" {
+		return fmt.Sprintf("BlobHub/%s/%s-%s", AppVersion, runtime.GOOS, runtime.GOARCH)
+	}
+	return fmt.Sprintf("BlobHub/%s-%s/%s-%s", AppVersion, commit, runtime.GOOS, runtime.GOARCH)
+}